@@ -0,0 +1,50 @@
+// Package raylib provides frame capture for the Spectrex framework.
+package raylib
+
+import (
+	"errors"
+	"fmt"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Pixel readback, swappable so tests can check which source is captured
+// without a GL context.
+var (
+	captureScreen  = rl.LoadImageFromScreen
+	captureTexture = func(texture rl.Texture2D) *rl.Image {
+		image := rl.LoadImageFromTexture(texture)
+		// Render textures are stored bottom-up
+		rl.ImageFlipVertical(image)
+		return image
+	}
+)
+
+// CaptureImage reads back the current frame. With a render texture it
+// captures the texture at RenderWidth x RenderHeight, before upscaling and
+// without 2D overlays; otherwise it captures the window, including anything
+// drawn so far. Call it after the scene is drawn (after End3DAndBlit when
+// using a render texture) and before EndFrame. The caller must release the
+// image with rl.UnloadImage.
+func (r *Renderer) CaptureImage() *rl.Image {
+	if r.useRenderTex {
+		return captureTexture(r.renderTarget.Texture)
+	}
+	return captureScreen()
+}
+
+// CaptureFrame saves the current frame to an image file, such as a PNG,
+// with the format chosen by the path's extension. See CaptureImage for
+// what is captured and when to call it.
+func (r *Renderer) CaptureFrame(path string) error {
+	image := r.CaptureImage()
+	if image == nil {
+		return errors.New("capturing frame: no image")
+	}
+	defer rl.UnloadImage(image)
+
+	if !rl.ExportImage(*image, path) {
+		return fmt.Errorf("capturing frame: exporting %s failed", path)
+	}
+	return nil
+}
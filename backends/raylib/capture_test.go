@@ -0,0 +1,58 @@
+package raylib
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// fakeCapture replaces the pixel readback functions for the test, recording
+// which one was called and returning an image of the captured size.
+func fakeCapture(t *testing.T, screenW, screenH int32) *string {
+	t.Helper()
+	var source string
+	oldScreen, oldTexture := captureScreen, captureTexture
+	t.Cleanup(func() { captureScreen, captureTexture = oldScreen, oldTexture })
+
+	captureScreen = func() *rl.Image {
+		source = "screen"
+		return &rl.Image{Width: screenW, Height: screenH}
+	}
+	captureTexture = func(texture rl.Texture2D) *rl.Image {
+		source = "texture"
+		return &rl.Image{Width: texture.Width, Height: texture.Height}
+	}
+	return &source
+}
+
+func TestRenderer_CaptureImage(t *testing.T) {
+	tests := []struct {
+		name         string
+		useRenderTex bool
+		wantSource   string
+		wantW, wantH int32
+	}{
+		{"window", false, "screen", 1920, 1080},
+		{"render texture", true, "texture", 320, 180},
+	}
+
+	for _, tt := range tests {
+		source := fakeCapture(t, 1920, 1080)
+		r := &Renderer{
+			ScreenWidth:  1920,
+			ScreenHeight: 1080,
+			RenderWidth:  320,
+			RenderHeight: 180,
+			useRenderTex: tt.useRenderTex,
+		}
+		r.renderTarget.Texture = rl.Texture2D{Width: 320, Height: 180}
+
+		image := r.CaptureImage()
+		if *source != tt.wantSource {
+			t.Errorf("%s: captured from %q, want %q", tt.name, *source, tt.wantSource)
+		}
+		if image.Width != tt.wantW || image.Height != tt.wantH {
+			t.Errorf("%s: captured %dx%d, want %dx%d", tt.name, image.Width, image.Height, tt.wantW, tt.wantH)
+		}
+	}
+}
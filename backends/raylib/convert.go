@@ -60,7 +60,7 @@ func rlToCoreMatrix(m rl.Matrix) core.Matrix {
 // coreToRlCamera converts core.Camera to rl.Camera3D.
 func coreToRlCamera(c core.Camera) rl.Camera3D {
 	projection := rl.CameraPerspective
-	if c.Projection == 1 {
+	if c.Projection == core.CameraOrthographic {
 		projection = rl.CameraOrthographic
 	}
 	return rl.Camera3D{
@@ -74,9 +74,9 @@ func coreToRlCamera(c core.Camera) rl.Camera3D {
 
 // rlToCoreCamera converts rl.Camera3D to core.Camera.
 func rlToCoreCamera(c rl.Camera3D) core.Camera {
-	projection := 0
+	projection := core.CameraPerspective
 	if c.Projection == rl.CameraOrthographic {
-		projection = 1
+		projection = core.CameraOrthographic
 	}
 	return core.Camera{
 		Position:   rlToCoreVec3(c.Position),
@@ -0,0 +1,52 @@
+package raylib
+
+import (
+	"math"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"github.com/chazu/spectrex/core"
+)
+
+func TestCameraConversion_RoundTrip(t *testing.T) {
+	for _, mode := range []int{core.CameraPerspective, core.CameraOrthographic} {
+		c := core.Camera{
+			Position:   core.Vec3{X: 1, Y: 2, Z: 3},
+			Target:     core.Vec3{X: 4, Y: 5, Z: 6},
+			Up:         core.Vec3{X: 0, Y: 1, Z: 0},
+			Fovy:       45,
+			Projection: mode,
+		}
+		if got := rlToCoreCamera(coreToRlCamera(c)); got != c {
+			t.Errorf("mode %d: round trip = %v, want %v", mode, got, c)
+		}
+	}
+
+	if got := coreToRlCamera(core.Camera{Projection: core.CameraOrthographic}).Projection; got != rl.CameraOrthographic {
+		t.Errorf("orthographic camera converted to projection %d", got)
+	}
+}
+
+func TestRenderer_SetProjection(t *testing.T) {
+	// The default camera has a 45 degree view, which is 2·d·tan(22.5°)
+	// tall at its target d units away
+	r := NewRenderer(640, 480)
+	base := rlToCoreCamera(r.GetCamera())
+	distance := base.Target.Sub(base.Position).Length()
+	wantHeight := 2 * distance * float32(math.Tan(float64(core.DegToRad(45))/2))
+
+	r.SetProjection(core.CameraOrthographic)
+	c := r.GetCamera()
+	if c.Projection != rl.CameraOrthographic {
+		t.Fatalf("Projection = %d, want orthographic", c.Projection)
+	}
+	if math.Abs(float64(c.Fovy-wantHeight)) > 1e-2 {
+		t.Errorf("orthographic Fovy = %f, want %f", c.Fovy, wantHeight)
+	}
+
+	r.SetProjection(core.CameraPerspective)
+	if c := r.GetCamera(); c.Projection != rl.CameraPerspective || math.Abs(float64(c.Fovy-45)) > 1e-3 {
+		t.Errorf("back to perspective = %d/%f, want perspective/45", c.Projection, c.Fovy)
+	}
+}
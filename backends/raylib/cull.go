@@ -0,0 +1,19 @@
+// Package raylib provides view frustum culling for the raylib backend.
+package raylib
+
+import "github.com/chazu/spectrex/core"
+
+// viewFrustum is the volume the camera of the current 3D mode sees,
+// recorded by Renderer.Begin3D and dropped by End3D, so text screens and
+// hex cells outside it can be skipped. Nothing is culled outside
+// Begin3D and End3D.
+var (
+	viewFrustum    core.Frustum
+	viewFrustumSet bool
+)
+
+// inView reports whether any part of the convex shape with the given
+// corners may be visible in the current 3D mode.
+func inView(points []core.Vec3) bool {
+	return !viewFrustumSet || viewFrustum.ContainsPoints(points)
+}
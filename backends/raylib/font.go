@@ -8,7 +8,16 @@ import (
 )
 
 // FontRenderer implements core.FontRenderer using raylib.
-type FontRenderer struct{}
+type FontRenderer struct {
+	// LineThickness is the world-space width of 3D glyph strokes.
+	// 0 draws raylib's 1px lines.
+	LineThickness float32
+
+	// Weight fakes bold text by drawing each stroke offset this many font
+	// units to either side as well; see core.GlyphStrokes. 0 draws plain
+	// strokes.
+	Weight float32
+}
 
 // NewFontRenderer creates a new raylib font renderer.
 func NewFontRenderer() *FontRenderer {
@@ -17,7 +26,7 @@ func NewFontRenderer() *FontRenderer {
 
 // DrawGlyph draws a single glyph at the specified position.
 func (fr *FontRenderer) DrawGlyph(font *core.HersheyFont, char int, position core.Vec3, color core.Color, scale float32) {
-	glyph, exists := font.Glyphs[char-31]
+	glyph, exists := font.Glyph(rune(char))
 	if !exists || len(glyph.Strokes) == 0 {
 		return
 	}
@@ -25,7 +34,7 @@ func (fr *FontRenderer) DrawGlyph(font *core.HersheyFont, char int, position cor
 	rlColor := coreToRlColor(color)
 	rlPos := coreToRlVec3(position)
 
-	for _, stroke := range glyph.Strokes {
+	for _, stroke := range core.GlyphStrokes(glyph, fr.Weight) {
 		start := rl.Vector3{
 			X: rlPos.X - stroke.From.X*scale,
 			Y: rlPos.Y + stroke.From.Y*scale,
@@ -36,59 +45,40 @@ func (fr *FontRenderer) DrawGlyph(font *core.HersheyFont, char int, position cor
 			Y: rlPos.Y + stroke.To.Y*scale,
 			Z: rlPos.Z,
 		}
-		rl.DrawLine3D(start, end, rlColor)
+		drawLine3D(start, end, fr.LineThickness, rlColor)
 	}
 }
 
 // DrawText draws a complete text string centered at the position.
 func (fr *FontRenderer) DrawText(font *core.HersheyFont, text string, position core.Vec3, color core.Color, scale float32) {
-	totalWidth := font.MeasureText(text, scale)
+	offsets, totalWidth := font.GlyphAdvances(text, scale)
 	startX := totalWidth / 2.0
-	xOffset := float32(0)
 
-	for _, char := range text {
+	for i, char := range []rune(text) {
 		if char < 32 || char > 126 {
 			continue
 		}
 
-		glyph, exists := font.Glyphs[int(char)-31]
-		if !exists {
-			xOffset += 8 * scale
-			continue
-		}
-
 		glyphPos := core.Vec3{
-			X: position.X + startX - xOffset,
+			X: position.X + startX - offsets[i],
 			Y: position.Y,
 			Z: position.Z,
 		}
 
 		fr.DrawGlyph(font, int(char), glyphPos, color, scale)
-
-		if glyph.RealWidth > 0 {
-			spacing := float32(glyph.RealWidth)
-			if spacing < 5 {
-				spacing = 5
-			}
-			xOffset += spacing * scale
-		} else {
-			xOffset += float32(glyph.Width) * scale
-		}
-
-		xOffset += 1.0 * scale
 	}
 }
 
 // DrawGlyphTransformed draws a glyph with a transformation matrix applied.
 func (fr *FontRenderer) DrawGlyphTransformed(font *core.HersheyFont, char int, position core.Vec3, color core.Color, scale float32, transform rl.Matrix) {
-	glyph, exists := font.Glyphs[char-31]
+	glyph, exists := font.Glyph(rune(char))
 	if !exists || len(glyph.Strokes) == 0 {
 		return
 	}
 
 	rlColor := coreToRlColor(color)
 
-	for _, stroke := range glyph.Strokes {
+	for _, stroke := range core.GlyphStrokes(glyph, fr.Weight) {
 		start := rl.Vector3{
 			X: position.X - stroke.From.X*scale,
 			Y: position.Y + stroke.From.Y*scale,
@@ -104,6 +94,49 @@ func (fr *FontRenderer) DrawGlyphTransformed(font *core.HersheyFont, char int, p
 		start = rl.Vector3Transform(start, transform)
 		end = rl.Vector3Transform(end, transform)
 
-		rl.DrawLine3D(start, end, rlColor)
+		drawLine3D(start, end, fr.LineThickness, rlColor)
+	}
+}
+
+// DrawText2D draws text in screen space with its top-left corner at
+// position. size is the font height in pixels. Call between End3DAndBlit
+// (or End3D) and EndFrame so the text overlays the scene.
+func (fr *FontRenderer) DrawText2D(font *core.HersheyFont, text string, position core.Vec2, size float32, color core.Color) {
+	scale := font.ScaleForSize(size)
+	offsets, _ := font.GlyphAdvances(text, scale)
+
+	// Glyph Y grows upward, so strokes hang from a baseline placed one
+	// ascent below the top edge
+	ascent, _ := font.Metrics()
+	baseline := position.Y + ascent*scale
+
+	for i, char := range []rune(text) {
+		if char < 32 || char > 126 {
+			continue
+		}
+		fr.DrawGlyph2D(font, int(char), core.Vec2{X: position.X + offsets[i], Y: baseline}, color, scale)
+	}
+}
+
+// DrawGlyph2D draws a single glyph in screen space with its origin on the
+// baseline at position.
+func (fr *FontRenderer) DrawGlyph2D(font *core.HersheyFont, char int, position core.Vec2, color core.Color, scale float32) {
+	glyph, exists := font.Glyph(rune(char))
+	if !exists || len(glyph.Strokes) == 0 {
+		return
+	}
+
+	rlColor := coreToRlColor(color)
+
+	for _, stroke := range core.GlyphStrokes(glyph, fr.Weight) {
+		start := rl.Vector2{
+			X: position.X + stroke.From.X*scale,
+			Y: position.Y - stroke.From.Y*scale,
+		}
+		end := rl.Vector2{
+			X: position.X + stroke.To.X*scale,
+			Y: position.Y - stroke.To.Y*scale,
+		}
+		drawLineV(start, end, rlColor)
 	}
 }
@@ -0,0 +1,40 @@
+package raylib
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"github.com/chazu/spectrex/core"
+)
+
+func TestFontRenderer_DrawText2DBaseline(t *testing.T) {
+	var lines [][2]rl.Vector2
+	saved := drawLineV
+	drawLineV = func(start, end rl.Vector2, color rl.Color) {
+		lines = append(lines, [2]rl.Vector2{start, end})
+	}
+	defer func() { drawLineV = saved }()
+
+	// A single vertical stroke from the baseline up to the ascent
+	font := core.NewHersheyFont()
+	font.Height = 20
+	font.Ascent, font.Descent = 12, 4
+	font.Glyphs['A'-31] = core.HersheyGlyph{
+		Width:   10,
+		Strokes: []core.Stroke{{From: core.Vec2{}, To: core.Vec2{Y: 12}}},
+	}
+
+	// size 40 doubles the font, so the baseline sits 24px below the top
+	NewFontRenderer().DrawText2D(font, "A", core.Vec2{X: 5, Y: 100}, 40, core.ColorWhite)
+
+	if len(lines) != 1 {
+		t.Fatalf("drew %d lines, want 1", len(lines))
+	}
+	if got := lines[0][0].Y; got != 124 {
+		t.Errorf("baseline Y = %v, want 124", got)
+	}
+	if got := lines[0][1].Y; got != 100 {
+		t.Errorf("ascent Y = %v, want the top edge 100", got)
+	}
+}
@@ -2,8 +2,6 @@
 package raylib
 
 import (
-	"math"
-
 	rl "github.com/gen2brain/raylib-go/raylib"
 
 	"github.com/chazu/spectrex/core"
@@ -13,9 +11,32 @@ import (
 type HexRenderer struct {
 	Config core.HexRenderConfig
 
+	// ColorResolver, if set, colors each edge as the blend of its two
+	// adjacent cells' colors. Edges styled by SetEdgeStyle or SetEdgeStyleFunc
+	// keep their color.
+	ColorResolver core.HexColorResolver
+
 	// Style overrides by coordinate
 	cellStyles map[core.HexCoord]core.HexCellStyle
 	edgeStyles map[core.HexEdge]core.HexEdgeStyle
+
+	// Computed edge styles, consulted for edges without an override
+	edgeStyleFn func(core.HexEdge) (core.HexEdgeStyle, bool)
+
+	// Transient highlights drawn over the normal styles
+	highlightCell      core.HexCoord
+	highlightCellStyle core.HexCellStyle
+	hasCellHighlight   bool
+	highlightEdge      core.HexEdge
+	highlightEdgeStyle core.HexEdgeStyle
+	hasEdgeHighlight   bool
+
+	// Batched cell fills uploaded by BuildGridMesh
+	mesh         rl.Mesh
+	meshMaterial rl.Material
+	meshData     core.HexGridRenderData
+	meshLoaded   bool
+	meshDirty    bool
 }
 
 // NewHexRenderer creates a new hex renderer with the given configuration.
@@ -30,11 +51,13 @@ func NewHexRenderer(config core.HexRenderConfig) *HexRenderer {
 // SetCellStyle sets a custom style for a specific cell.
 func (r *HexRenderer) SetCellStyle(coord core.HexCoord, style core.HexCellStyle) {
 	r.cellStyles[coord] = style
+	r.meshDirty = true
 }
 
 // ClearCellStyle removes the custom style for a cell, reverting to default.
 func (r *HexRenderer) ClearCellStyle(coord core.HexCoord) {
 	delete(r.cellStyles, coord)
+	r.meshDirty = true
 }
 
 // SetEdgeStyle sets a custom style for a specific edge.
@@ -47,10 +70,66 @@ func (r *HexRenderer) ClearEdgeStyle(edge core.HexEdge) {
 	delete(r.edgeStyles, edge)
 }
 
-// ClearAllStyles removes all custom styles.
+// SetEdgeStyleFunc sets a function that styles edges across the whole grid,
+// such as every boundary edge. For each edge without a SetEdgeStyle
+// override, fn returns the style to draw and true, or false to fall back to
+// the default. Pass nil to remove it.
+func (r *HexRenderer) SetEdgeStyleFunc(fn func(edge core.HexEdge) (core.HexEdgeStyle, bool)) {
+	r.edgeStyleFn = fn
+}
+
+// ClearAllStyles removes all custom styles, including the SetEdgeStyleFunc
+// function.
 func (r *HexRenderer) ClearAllStyles() {
 	r.cellStyles = make(map[core.HexCoord]core.HexCellStyle)
 	r.edgeStyles = make(map[core.HexEdge]core.HexEdgeStyle)
+	r.edgeStyleFn = nil
+	r.meshDirty = true
+}
+
+// SetHighlight highlights a cell, drawing style's fill over the cell's
+// normal style until ClearHighlight. Only one cell is highlighted at a time;
+// the style overrides set with SetCellStyle are left untouched.
+func (r *HexRenderer) SetHighlight(coord core.HexCoord, style core.HexCellStyle) {
+	r.highlightCell = coord
+	r.highlightCellStyle = style
+	r.hasCellHighlight = true
+}
+
+// ClearHighlight removes the cell highlight.
+func (r *HexRenderer) ClearHighlight() {
+	r.hasCellHighlight = false
+}
+
+// SetEdgeHighlight highlights an edge, drawing it with style over its
+// normal style until ClearEdgeHighlight. Only one edge is highlighted at a
+// time. Pass HexHitResult.Edge to highlight the edge under the mouse.
+func (r *HexRenderer) SetEdgeHighlight(edge core.HexEdge, style core.HexEdgeStyle) {
+	r.highlightEdge = edge
+	r.highlightEdgeStyle = style
+	r.hasEdgeHighlight = true
+}
+
+// ClearEdgeHighlight removes the edge highlight.
+func (r *HexRenderer) ClearEdgeHighlight() {
+	r.hasEdgeHighlight = false
+}
+
+// drawCellHighlight draws the highlighted cell's fill, if any.
+func (r *HexRenderer) drawCellHighlight() {
+	if r.hasCellHighlight && r.highlightCellStyle.FillColor.A > 0 {
+		vertices := r.Config.CellVertices3D(r.highlightCell)
+		r.drawCellFill(vertices, r.highlightCellStyle.FillColor)
+	}
+}
+
+// drawEdgeHighlight draws the highlighted edge, if any.
+func (r *HexRenderer) drawEdgeHighlight() {
+	if r.hasEdgeHighlight {
+		vertices := r.Config.CellVertices3D(r.highlightEdge.Coord)
+		v1, v2 := core.HexEdgeVertices3D(vertices, r.highlightEdge.Dir, r.Config.Layout.Orientation)
+		r.drawEdgeLine(v1, v2, r.highlightEdgeStyle)
+	}
 }
 
 // getCellStyle returns the style for a cell, using override if set.
@@ -61,17 +140,39 @@ func (r *HexRenderer) getCellStyle(coord core.HexCoord) core.HexCellStyle {
 	return r.Config.DefaultCell
 }
 
-// getEdgeStyle returns the style for an edge, using override if set.
-func (r *HexRenderer) getEdgeStyle(edge core.HexEdge) core.HexEdgeStyle {
+// resolveEdgeStyle returns the style an edge is drawn with: its override if
+// set, then the SetEdgeStyleFunc style, otherwise the default with the
+// blended color from ColorResolver.
+func (r *HexRenderer) resolveEdgeStyle(edge core.HexEdge, inGrid func(core.HexCoord) bool) core.HexEdgeStyle {
+	style, overridden := r.getEdgeStyle(edge)
+	if !overridden && r.ColorResolver != nil {
+		style.Color = core.BlendedEdgeColor(edge, inGrid, r.ColorResolver)
+	}
+	return style
+}
+
+// getEdgeStyle returns the style for an edge, using its override or the
+// SetEdgeStyleFunc style if set, and reports whether either applied.
+func (r *HexRenderer) getEdgeStyle(edge core.HexEdge) (core.HexEdgeStyle, bool) {
 	if style, ok := r.edgeStyles[edge]; ok {
-		return style
+		return style, true
+	}
+	if r.edgeStyleFn != nil {
+		if style, ok := r.edgeStyleFn(edge); ok {
+			return style, true
+		}
 	}
-	return r.Config.DefaultEdge
+	return r.Config.DefaultEdge, false
 }
 
 // DrawGrid renders the entire hex grid.
 func (r *HexRenderer) DrawGrid(data core.HexGridRenderData) {
-	// Draw cells first (so edges appear on top)
+	// Draw walls and cells first (so edges appear on top)
+	if r.Config.DrawCells && r.Config.DrawWalls {
+		r.drawWalls(data.Walls, func(coord core.HexCoord) core.Color {
+			return r.getCellStyle(coord).FillColor
+		})
+	}
 	if r.Config.DrawCells {
 		for i, coord := range data.Cells {
 			style := r.getCellStyle(coord)
@@ -80,12 +181,83 @@ func (r *HexRenderer) DrawGrid(data core.HexGridRenderData) {
 			}
 		}
 	}
+	r.drawCellHighlight()
 
 	// Draw edges
 	if r.Config.DrawEdges {
 		// Draw all edges (interior and boundary)
 		r.drawEdges(data.AllEdges, data)
 	}
+	r.drawEdgeHighlight()
+}
+
+// BuildGridMesh uploads the cell fills of data as a single mesh, so a static
+// grid's fills draw in one call with DrawMesh instead of one call per
+// triangle. Any previously built mesh is released. Requires an open window.
+func (r *HexRenderer) BuildGridMesh(data core.HexGridRenderData) {
+	r.UnloadMesh()
+
+	built := core.BuildHexGridMesh(data, func(coord core.HexCoord) core.Color {
+		return r.getCellStyle(coord).FillColor
+	})
+
+	r.meshData = data
+	r.meshDirty = false
+	if built.VertexCount() == 0 {
+		return
+	}
+
+	r.mesh = rl.Mesh{
+		VertexCount:   int32(built.VertexCount()),
+		TriangleCount: int32(built.TriangleCount()),
+		Vertices:      &built.Vertices[0],
+		Colors:        &built.Colors[0],
+	}
+	rl.UploadMesh(&r.mesh, false)
+	r.meshMaterial = rl.LoadMaterialDefault()
+	r.meshLoaded = true
+}
+
+// DrawMesh draws the grid built by BuildGridMesh: cell fills as one mesh,
+// then edges as in DrawGrid. The mesh is rebuilt first if cell styles have
+// changed since it was built.
+func (r *HexRenderer) DrawMesh() {
+	if r.meshDirty {
+		r.BuildGridMesh(r.meshData)
+	}
+
+	if r.Config.DrawCells && r.meshLoaded {
+		rl.DrawMesh(r.mesh, r.meshMaterial, rl.MatrixIdentity())
+	}
+	// Walls are not part of the mesh
+	if r.Config.DrawCells && r.Config.DrawWalls {
+		r.drawWalls(r.meshData.Walls, func(coord core.HexCoord) core.Color {
+			return r.getCellStyle(coord).FillColor
+		})
+	}
+	r.drawCellHighlight()
+
+	if r.Config.DrawEdges {
+		r.drawEdges(r.meshData.AllEdges, r.meshData)
+	}
+	r.drawEdgeHighlight()
+}
+
+// InvalidateMesh marks the mesh for rebuilding on the next DrawMesh. Style
+// override changes do this automatically; call it after changing Config.
+func (r *HexRenderer) InvalidateMesh() {
+	r.meshDirty = true
+}
+
+// UnloadMesh releases the GPU resources held by the grid mesh.
+func (r *HexRenderer) UnloadMesh() {
+	if !r.meshLoaded {
+		return
+	}
+	rl.UnloadMesh(&r.mesh)
+	rl.UnloadMaterial(r.meshMaterial)
+	r.mesh = rl.Mesh{}
+	r.meshLoaded = false
 }
 
 // DrawGridBoundaryOnly renders only the boundary edges of the grid.
@@ -95,7 +267,7 @@ func (r *HexRenderer) DrawGridBoundaryOnly(data core.HexGridRenderData) {
 
 // DrawCell renders a single hex cell at the given coordinate.
 func (r *HexRenderer) DrawCell(coord core.HexCoord, style core.HexCellStyle) {
-	vertices := core.HexVertices3D(r.Config.Layout, coord, r.Config.HexRadius)
+	vertices := r.Config.CellVertices3D(coord)
 	if style.FillColor.A > 0 {
 		r.drawCellFill(vertices, style.FillColor)
 	}
@@ -103,15 +275,60 @@ func (r *HexRenderer) DrawCell(coord core.HexCoord, style core.HexCellStyle) {
 
 // DrawCellEdges renders all edges of a single cell.
 func (r *HexRenderer) DrawCellEdges(coord core.HexCoord, style core.HexEdgeStyle) {
-	vertices := core.HexVertices3D(r.Config.Layout, coord, r.Config.HexRadius)
+	vertices := r.Config.CellVertices3D(coord)
 	for dir := core.HexDirE; dir <= core.HexDirSE; dir++ {
-		v1, v2 := core.HexEdgeVertices3D(vertices, dir)
+		v1, v2 := core.HexEdgeVertices3D(vertices, dir, r.Config.Layout.Orientation)
 		r.drawEdgeLine(v1, v2, style)
 	}
 }
 
-// drawCellFill renders a filled hex using triangles.
+// DrawCellLabel draws text centered in a cell, lying flat on the grid plane
+// and scaled to fit inside the hex. Text reads upright from a camera on the
+// -Z side of the grid, the same way text screens face.
+func (r *HexRenderer) DrawCellLabel(coord core.HexCoord, text string, font *core.HersheyFont, color core.Color) {
+	scale := core.HexLabelScale(font, text, r.Config.CellRadius())
+	if scale <= 0 {
+		return
+	}
+
+	center := core.HexCenter3D(r.Config.Layout, coord)
+	center.Y = r.Config.Elevation(coord)
+	offsets, width := font.GlyphAdvances(text, scale)
+
+	// Glyphs advance toward -X, the viewer's right, with glyph Y along +Z.
+	// The baseline sits below center so the ink from descent to ascent is
+	// centered on the cell.
+	startX := center.X + width/2
+	ascent, descent := font.Metrics()
+	baseline := center.Z - (ascent-descent)*scale/2
+	// Lift slightly off the plane so labels don't z-fight cell fills
+	y := center.Y + 0.1
+	rlColor := coreToRlColor(color)
+
+	for i, char := range []rune(text) {
+		if char < 32 || char > 126 {
+			continue
+		}
+		glyph, exists := font.Glyph(char)
+		if !exists {
+			continue
+		}
+
+		originX := startX - offsets[i]
+		for _, stroke := range glyph.Strokes {
+			start := rl.Vector3{X: originX - stroke.From.X*scale, Y: y, Z: baseline + stroke.From.Y*scale}
+			end := rl.Vector3{X: originX - stroke.To.X*scale, Y: y, Z: baseline + stroke.To.Y*scale}
+			drawLine3D(start, end, 0, rlColor)
+		}
+	}
+}
+
+// drawCellFill renders a filled hex using triangles, unless it is out of
+// view.
 func (r *HexRenderer) drawCellFill(vertices [6]core.Vec3, color core.Color) {
+	if !inView(vertices[:]) {
+		return
+	}
 	rlColor := coreToRlColor(color)
 	center := core.Vec3{
 		X: (vertices[0].X + vertices[3].X) / 2,
@@ -131,83 +348,71 @@ func (r *HexRenderer) drawCellFill(vertices [6]core.Vec3, color core.Color) {
 	}
 }
 
+// drawWalls fills the walls of raised cells, shaded from the fill color
+// returned for their cell. Walls are seen from either side as the camera
+// moves around the terrain, so both windings are drawn.
+func (r *HexRenderer) drawWalls(walls []core.HexWall, fill func(coord core.HexCoord) core.Color) {
+	for _, wall := range walls {
+		color := fill(wall.Coord)
+		if color.A == 0 || !inView(wall.Vertices[:]) {
+			continue
+		}
+		rlColor := coreToRlColor(core.HexWallColor(color))
+		var v [4]rl.Vector3
+		for i, p := range wall.Vertices {
+			v[i] = coreToRlVec3(p)
+		}
+		rl.DrawTriangle3D(v[0], v[1], v[2], rlColor)
+		rl.DrawTriangle3D(v[0], v[2], v[3], rlColor)
+		rl.DrawTriangle3D(v[0], v[2], v[1], rlColor)
+		rl.DrawTriangle3D(v[0], v[3], v[2], rlColor)
+	}
+}
+
 // drawEdges renders a list of edges.
 func (r *HexRenderer) drawEdges(edges []core.HexEdge, data core.HexGridRenderData) {
-	// Build a coordinate to index map for fast lookup
-	coordIndex := make(map[core.HexCoord]int, len(data.Cells))
-	for i, coord := range data.Cells {
-		coordIndex[coord] = i
+	inGrid := func(coord core.HexCoord) bool {
+		_, ok := data.IndexOf(coord)
+		return ok
 	}
 
 	for _, edge := range edges {
-		style := r.getEdgeStyle(edge)
+		style := r.resolveEdgeStyle(edge, inGrid)
 
 		// Find the vertices for this edge
-		idx, ok := coordIndex[edge.Coord]
+		idx, ok := data.IndexOf(edge.Coord)
 		if !ok {
 			// Compute vertices on the fly if not in pre-computed data
-			vertices := core.HexVertices3D(r.Config.Layout, edge.Coord, r.Config.HexRadius)
-			v1, v2 := core.HexEdgeVertices3D(vertices, edge.Dir)
+			vertices := r.Config.CellVertices3D(edge.Coord)
+			v1, v2 := core.HexEdgeVertices3D(vertices, edge.Dir, r.Config.Layout.Orientation)
 			r.drawEdgeLine(v1, v2, style)
 		} else {
-			v1, v2 := core.HexEdgeVertices3D(data.Vertices[idx], edge.Dir)
+			v1, v2 := core.HexEdgeVertices3D(data.Vertices[idx], edge.Dir, r.Config.Layout.Orientation)
 			r.drawEdgeLine(v1, v2, style)
 		}
 	}
 }
 
-// drawEdgeLine renders a single edge line with the given style.
+// drawEdgeLine renders a single edge line with the given style, unless it
+// is out of view.
 func (r *HexRenderer) drawEdgeLine(v1, v2 core.Vec3, style core.HexEdgeStyle) {
+	if !inView([]core.Vec3{v1, v2}) {
+		return
+	}
 	rlColor := coreToRlColor(style.Color)
 
 	if style.Dashed {
-		r.drawDashedLine3D(v1, v2, r.Config.DashLength, r.Config.DashGap, rlColor)
+		r.drawDashedLine3D(v1, v2, r.Config.DashLength, r.Config.DashGap, style.DashOffset, style.Thickness, rlColor)
 	} else {
-		rl.DrawLine3D(coreToRlVec3(v1), coreToRlVec3(v2), rlColor)
+		drawLine3D(coreToRlVec3(v1), coreToRlVec3(v2), style.Thickness, rlColor)
 	}
 }
 
-// drawDashedLine3D draws a dashed line between two points.
-func (r *HexRenderer) drawDashedLine3D(start, end core.Vec3, dashLen, gapLen float32, color rl.Color) {
-	dx := end.X - start.X
-	dy := end.Y - start.Y
-	dz := end.Z - start.Z
-	totalLen := float32(math.Sqrt(float64(dx*dx + dy*dy + dz*dz)))
-
-	if totalLen == 0 {
-		return
-	}
-
-	// Normalize direction
-	dx /= totalLen
-	dy /= totalLen
-	dz /= totalLen
-
-	segmentLen := dashLen + gapLen
-	pos := float32(0)
-
-	for pos < totalLen {
-		// Start of this dash
-		p1 := core.Vec3{
-			X: start.X + dx*pos,
-			Y: start.Y + dy*pos,
-			Z: start.Z + dz*pos,
-		}
-
-		// End of this dash (clamped to line end)
-		dashEnd := pos + dashLen
-		if dashEnd > totalLen {
-			dashEnd = totalLen
-		}
-		p2 := core.Vec3{
-			X: start.X + dx*dashEnd,
-			Y: start.Y + dy*dashEnd,
-			Z: start.Z + dz*dashEnd,
-		}
-
-		rl.DrawLine3D(coreToRlVec3(p1), coreToRlVec3(p2), color)
-
-		pos += segmentLen
+// drawDashedLine3D draws a dashed line between two points, with the dash
+// pattern shifted offset along it.
+func (r *HexRenderer) drawDashedLine3D(start, end core.Vec3, dashLen, gapLen, offset, thickness float32, color rl.Color) {
+	for _, dash := range core.DashSegments(start, end, dashLen, gapLen, offset) {
+		drawLine3D(coreToRlVec3(dash[0]), coreToRlVec3(dash[1]), thickness, color)
 	}
 }
 
@@ -219,6 +424,14 @@ func (r *HexRenderer) DrawGridWithCallback(
 	edgeStyleFn func(edge core.HexEdge) *core.HexEdgeStyle,
 ) {
 	// Draw cells
+	if r.Config.DrawCells && r.Config.DrawWalls && cellStyleFn != nil {
+		r.drawWalls(data.Walls, func(coord core.HexCoord) core.Color {
+			if style := cellStyleFn(coord); style != nil {
+				return style.FillColor
+			}
+			return core.Color{}
+		})
+	}
 	if r.Config.DrawCells && cellStyleFn != nil {
 		for i, coord := range data.Cells {
 			if style := cellStyleFn(coord); style != nil && style.FillColor.A > 0 {
@@ -226,23 +439,18 @@ func (r *HexRenderer) DrawGridWithCallback(
 			}
 		}
 	}
+	r.drawCellHighlight()
 
 	// Draw edges
 	if r.Config.DrawEdges && edgeStyleFn != nil {
 		for _, edge := range data.AllEdges {
 			if style := edgeStyleFn(edge); style != nil {
-				idx := -1
-				for i, coord := range data.Cells {
-					if coord == edge.Coord {
-						idx = i
-						break
-					}
-				}
-				if idx >= 0 {
-					v1, v2 := core.HexEdgeVertices3D(data.Vertices[idx], edge.Dir)
+				if idx, ok := data.IndexOf(edge.Coord); ok {
+					v1, v2 := core.HexEdgeVertices3D(data.Vertices[idx], edge.Dir, r.Config.Layout.Orientation)
 					r.drawEdgeLine(v1, v2, *style)
 				}
 			}
 		}
 	}
+	r.drawEdgeHighlight()
 }
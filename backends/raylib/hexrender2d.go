@@ -0,0 +1,112 @@
+// Package raylib provides 2D screen-space hex grid rendering for the raylib backend.
+package raylib
+
+import (
+	"math"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"github.com/chazu/spectrex/core"
+)
+
+// DrawGrid2D renders the grid in screen space, for HUD-style grids such as
+// minimaps. The layout's pixel coordinates are used directly as screen
+// pixels, so set Config.Layout.Origin to where cell (0, 0) should appear.
+// Styles, overrides and highlights are shared with the 3D path; edge
+// Thickness is in pixels, and RoundJoints rounds thick edges' ends so
+// they meet cleanly at corners. Call after End3DAndBlit (or End3D) and before
+// EndFrame.
+func (r *HexRenderer) DrawGrid2D(data core.HexGridRenderData) {
+	if r.Config.DrawCells {
+		for i, coord := range data.Cells {
+			style := r.getCellStyle(coord)
+			if style.FillColor.A > 0 {
+				r.drawCellFill2D(flattenVertices(data.Vertices[i]), style.FillColor)
+			}
+		}
+	}
+	if r.hasCellHighlight && r.highlightCellStyle.FillColor.A > 0 {
+		vertices := core.HexVertices(r.Config.Layout, r.highlightCell, r.Config.CellRadius())
+		r.drawCellFill2D(vertices, r.highlightCellStyle.FillColor)
+	}
+
+	if r.Config.DrawEdges {
+		inGrid := func(coord core.HexCoord) bool {
+			_, ok := data.IndexOf(coord)
+			return ok
+		}
+
+		for _, edge := range data.AllEdges {
+			var vertices [6]core.Vec2
+			if idx, ok := data.IndexOf(edge.Coord); ok {
+				vertices = flattenVertices(data.Vertices[idx])
+			} else {
+				vertices = core.HexVertices(r.Config.Layout, edge.Coord, r.Config.CellRadius())
+			}
+			v1, v2 := core.HexEdgeVertices(vertices, edge.Dir, r.Config.Layout.Orientation)
+			r.drawEdgeLine2D(v1, v2, r.resolveEdgeStyle(edge, inGrid))
+		}
+	}
+	if r.hasEdgeHighlight {
+		vertices := core.HexVertices(r.Config.Layout, r.highlightEdge.Coord, r.Config.CellRadius())
+		v1, v2 := core.HexEdgeVertices(vertices, r.highlightEdge.Dir, r.Config.Layout.Orientation)
+		r.drawEdgeLine2D(v1, v2, r.highlightEdgeStyle)
+	}
+}
+
+// flattenVertices maps 3D grid vertices on the XZ plane back to the 2D
+// layout coordinates they were computed from.
+func flattenVertices(vertices [6]core.Vec3) [6]core.Vec2 {
+	var flat [6]core.Vec2
+	for i, v := range vertices {
+		flat[i] = core.Vec2{X: v.X, Y: v.Z}
+	}
+	return flat
+}
+
+// drawCellFill2D renders a filled hex in screen space as a triangle fan.
+func (r *HexRenderer) drawCellFill2D(vertices [6]core.Vec2, color core.Color) {
+	center := core.Vec2{
+		X: (vertices[0].X + vertices[3].X) / 2,
+		Y: (vertices[0].Y + vertices[3].Y) / 2,
+	}
+
+	// HexVertices run clockwise on screen; raylib wants counter-clockwise
+	points := make([]rl.Vector2, 0, 8)
+	points = append(points, coreToRlVec2(center))
+	for i := 6; i >= 0; i-- {
+		points = append(points, coreToRlVec2(vertices[i%6]))
+	}
+
+	rl.DrawTriangleFan(points, coreToRlColor(color))
+}
+
+// drawEdgeLine2D renders a single edge in screen space with the given style.
+// Round joints give each dash of a dashed edge round ends too.
+func (r *HexRenderer) drawEdgeLine2D(v1, v2 core.Vec2, style core.HexEdgeStyle) {
+	color := coreToRlColor(style.Color)
+	drawLine := drawLine2D
+	if style.RoundJoints {
+		drawLine = drawRoundLine2D
+	}
+
+	if !style.Dashed || r.Config.DashLength+r.Config.DashGap <= 0 {
+		drawLine(coreToRlVec2(v1), coreToRlVec2(v2), style.Thickness, color)
+		return
+	}
+
+	dx := v2.X - v1.X
+	dy := v2.Y - v1.Y
+	totalLen := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+	if totalLen == 0 {
+		return
+	}
+	dx /= totalLen
+	dy /= totalLen
+
+	for _, dash := range core.DashIntervals(totalLen, r.Config.DashLength, r.Config.DashGap, style.DashOffset) {
+		start := rl.Vector2{X: v1.X + dx*dash[0], Y: v1.Y + dy*dash[0]}
+		end := rl.Vector2{X: v1.X + dx*dash[1], Y: v1.Y + dy*dash[1]}
+		drawLine(start, end, style.Thickness, color)
+	}
+}
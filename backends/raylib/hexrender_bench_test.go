@@ -0,0 +1,63 @@
+package raylib
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"github.com/chazu/spectrex/core"
+)
+
+// Compares immediate-mode and batched cell fills for a radius 20 grid.
+// These need a GL context, so they open a hidden window:
+//
+//	go test -run '^$' -bench HexGridFills ./backends/raylib
+
+func openBenchWindow(b *testing.B) {
+	b.Helper()
+	rl.SetConfigFlags(rl.FlagWindowHidden)
+	rl.SetTraceLogLevel(rl.LogWarning)
+	rl.InitWindow(320, 240, "spectrex bench")
+	if !rl.IsWindowReady() {
+		b.Skip("no display available")
+	}
+	b.Cleanup(rl.CloseWindow)
+}
+
+func benchGridRenderer() (*HexRenderer, core.HexGridRenderData) {
+	config := core.DefaultHexRenderConfig(10)
+	config.DefaultCell.FillColor = core.ColorBlue
+	config.DrawEdges = false
+	grid := core.NewHexGrid[int](20)
+	return NewHexRenderer(config), core.PrepareGridRenderData(grid, config)
+}
+
+func BenchmarkHexGridFills_PerTriangle(b *testing.B) {
+	openBenchWindow(b)
+	r, data := benchGridRenderer()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rl.BeginDrawing()
+		rl.BeginMode3D(rl.Camera3D{Position: rl.Vector3{Y: 400, Z: -400}, Up: rl.Vector3{Y: 1}, Fovy: 45})
+		r.DrawGrid(data)
+		rl.EndMode3D()
+		rl.EndDrawing()
+	}
+}
+
+func BenchmarkHexGridFills_Batched(b *testing.B) {
+	openBenchWindow(b)
+	r, data := benchGridRenderer()
+	r.BuildGridMesh(data)
+	defer r.UnloadMesh()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rl.BeginDrawing()
+		rl.BeginMode3D(rl.Camera3D{Position: rl.Vector3{Y: 400, Z: -400}, Up: rl.Vector3{Y: 1}, Fovy: 45})
+		r.DrawMesh()
+		rl.EndMode3D()
+		rl.EndDrawing()
+	}
+}
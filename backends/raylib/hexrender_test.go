@@ -0,0 +1,61 @@
+package raylib
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"github.com/chazu/spectrex/core"
+)
+
+func TestHexRenderer_EdgeThickness2D(t *testing.T) {
+	type call struct {
+		kind       string
+		start, end rl.Vector2
+		size       float32
+	}
+	var calls []call
+
+	savedV, savedEx, savedCircle := drawLineV, drawLineEx, drawCircleV
+	drawLineV = func(start, end rl.Vector2, color rl.Color) {
+		calls = append(calls, call{"line", start, end, 0})
+	}
+	drawLineEx = func(start, end rl.Vector2, thick float32, color rl.Color) {
+		calls = append(calls, call{"lineEx", start, end, thick})
+	}
+	drawCircleV = func(center rl.Vector2, radius float32, color rl.Color) {
+		calls = append(calls, call{"circle", center, center, radius})
+	}
+	defer func() { drawLineV, drawLineEx, drawCircleV = savedV, savedEx, savedCircle }()
+
+	a, b := rl.Vector2{X: 1, Y: 2}, rl.Vector2{X: 11, Y: 2}
+	tests := []struct {
+		name  string
+		style core.HexEdgeStyle
+		want  []call
+	}{
+		{"thin", core.HexEdgeStyle{}, []call{{"line", a, b, 0}}},
+		{"thin round", core.HexEdgeStyle{RoundJoints: true}, []call{{"line", a, b, 0}}},
+		{"thick", core.HexEdgeStyle{Thickness: 3}, []call{{"lineEx", a, b, 3}}},
+		{"thick round", core.HexEdgeStyle{Thickness: 3, RoundJoints: true}, []call{
+			{"lineEx", a, b, 3},
+			{"circle", a, a, 1.5},
+			{"circle", b, b, 1.5},
+		}},
+	}
+
+	r := NewHexRenderer(core.DefaultHexRenderConfig(10))
+	for _, tt := range tests {
+		calls = nil
+		r.drawEdgeLine2D(rlToCoreVec2(a), rlToCoreVec2(b), tt.style)
+		if len(calls) != len(tt.want) {
+			t.Errorf("%s: drew %v, want %v", tt.name, calls, tt.want)
+			continue
+		}
+		for i := range calls {
+			if calls[i] != tt.want[i] {
+				t.Errorf("%s: call %d = %v, want %v", tt.name, i, calls[i], tt.want[i])
+			}
+		}
+	}
+}
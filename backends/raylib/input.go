@@ -0,0 +1,53 @@
+// Package raylib provides keyboard and mouse input for the raylib backend.
+package raylib
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"github.com/chazu/spectrex/core"
+)
+
+// Input implements core.Input by reading raylib's input state. core's key
+// and mouse button codes are raylib's, so they pass straight through.
+type Input struct{}
+
+// NewInput creates a raylib input reader. Requires an open window.
+func NewInput() *Input {
+	return &Input{}
+}
+
+// IsKeyPressed reports whether key went down since the previous frame.
+func (i *Input) IsKeyPressed(key core.Key) bool {
+	return rl.IsKeyPressed(int32(key))
+}
+
+// IsKeyDown reports whether key is held down.
+func (i *Input) IsKeyDown(key core.Key) bool {
+	return rl.IsKeyDown(int32(key))
+}
+
+// MousePosition returns the mouse position in window pixels.
+func (i *Input) MousePosition() core.Vec2 {
+	return rlToCoreVec2(rl.GetMousePosition())
+}
+
+// IsMouseButtonPressed reports whether button went down since the previous
+// frame.
+func (i *Input) IsMouseButtonPressed(button core.MouseButton) bool {
+	return rl.IsMouseButtonPressed(int32(button))
+}
+
+// IsMouseButtonDown reports whether button is held down.
+func (i *Input) IsMouseButtonDown(button core.MouseButton) bool {
+	return rl.IsMouseButtonDown(int32(button))
+}
+
+// MouseWheel returns how far the wheel turned this frame.
+func (i *Input) MouseWheel() float32 {
+	return rl.GetMouseWheelMove()
+}
+
+// FrameTime returns the duration of the last frame in seconds.
+func (i *Input) FrameTime() float32 {
+	return rl.GetFrameTime()
+}
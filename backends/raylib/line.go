@@ -0,0 +1,94 @@
+// Package raylib provides thick line rendering for the raylib backend.
+package raylib
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"github.com/chazu/spectrex/core"
+)
+
+// viewPosition is the camera position of the current 3D mode, recorded by
+// Renderer.Begin3D. raylib keeps its 3D mode as global state, so thick
+// lines drawn by any renderer in this package face the same camera.
+var viewPosition rl.Vector3
+
+// viewCamera is the whole camera of the current 3D mode, for drawing that
+// needs more than its position.
+var viewCamera core.Camera
+
+// drawLine3D draws a line segment thickness world units wide, as a quad
+// turned to face the camera. A thickness of 0 draws raylib's 1px line.
+func drawLine3D(start, end rl.Vector3, thickness float32, color rl.Color) {
+	if thickness <= 0 {
+		rl.DrawLine3D(start, end, color)
+		return
+	}
+
+	corners, ok := core.LineQuad(rlToCoreVec3(start), rlToCoreVec3(end), rlToCoreVec3(viewPosition), thickness)
+	if !ok {
+		rl.DrawLine3D(start, end, color)
+		return
+	}
+
+	a := coreToRlVec3(corners[0])
+	b := coreToRlVec3(corners[1])
+	c := coreToRlVec3(corners[2])
+	d := coreToRlVec3(corners[3])
+
+	// Draw both windings so backface culling never hides the quad
+	rl.DrawTriangle3D(a, b, c, color)
+	rl.DrawTriangle3D(a, c, d, color)
+	rl.DrawTriangle3D(a, c, b, color)
+	rl.DrawTriangle3D(a, d, c, color)
+}
+
+// roundCapSegments is the number of sides of the discs that round off
+// thick lines.
+const roundCapSegments = 8
+
+// drawDisc3D draws a filled disc turned to face the camera, such as to
+// round the end of a thick line.
+func drawDisc3D(center rl.Vector3, radius float32, color rl.Color) {
+	c := rlToCoreVec3(center)
+	normal := rlToCoreVec3(viewPosition).Sub(c)
+	if normal.Length() == 0 {
+		return
+	}
+
+	points := core.CirclePoints(c, radius, normal, roundCapSegments)
+	for i := range points {
+		a := coreToRlVec3(points[i])
+		b := coreToRlVec3(points[(i+1)%len(points)])
+		// Draw both windings so backface culling never hides the disc
+		rl.DrawTriangle3D(center, a, b, color)
+		rl.DrawTriangle3D(center, b, a, color)
+	}
+}
+
+// raylib's 2D line and circle calls, swappable so tests can record what
+// is drawn without a window.
+var (
+	drawLineV   = rl.DrawLineV
+	drawLineEx  = rl.DrawLineEx
+	drawCircleV = rl.DrawCircleV
+)
+
+// drawLine2D draws a screen-space line thickness pixels wide.
+// A thickness of 0 draws a 1px line.
+func drawLine2D(start, end rl.Vector2, thickness float32, color rl.Color) {
+	if thickness <= 0 {
+		drawLineV(start, end, color)
+		return
+	}
+	drawLineEx(start, end, thickness, color)
+}
+
+// drawRoundLine2D draws a screen-space line thickness pixels wide with
+// round ends. A thickness of 0 draws a 1px line.
+func drawRoundLine2D(start, end rl.Vector2, thickness float32, color rl.Color) {
+	drawLine2D(start, end, thickness, color)
+	if thickness > 0 {
+		drawCircleV(start, thickness/2, color)
+		drawCircleV(end, thickness/2, color)
+	}
+}
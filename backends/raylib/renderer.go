@@ -7,6 +7,19 @@ import (
 	"github.com/chazu/spectrex/core"
 )
 
+// ScaleMode controls how a fixed-resolution render texture is scaled to the
+// window.
+type ScaleMode int
+
+const (
+	// ScaleFit scales the render texture by whatever factor fills the
+	// window while keeping its aspect ratio.
+	ScaleFit ScaleMode = iota
+	// ScaleInteger scales the render texture only by whole multiples, at
+	// least 1x, for crisp pixel-perfect upscaling.
+	ScaleInteger
+)
+
 // Renderer implements core.Renderer using raylib.
 type Renderer struct {
 	ScreenWidth  int32
@@ -19,6 +32,33 @@ type Renderer struct {
 	renderTarget  rl.RenderTexture2D
 	useRenderTex  bool
 	windowResized bool
+
+	// LineThickness is the world-space width of lines drawn by DrawLine3D.
+	// 0 draws raylib's 1px lines.
+	LineThickness float32
+
+	// ClearColor fills the frame before drawing. Defaults to black.
+	ClearColor core.Color
+
+	// LetterboxColor fills the bars around a render texture scaled to a
+	// window of a different shape. Defaults to black.
+	LetterboxColor core.Color
+
+	// Optional bounds that the camera target is kept within
+	targetBounded bool
+	targetMin     core.Vec3
+	targetMax     core.Vec3
+
+	// How the render texture is scaled to the window
+	scaleMode ScaleMode
+
+	// Optional projection mode that overrides the camera's own
+	projectionSet bool
+	projection    int
+
+	// Window size to restore when leaving fullscreen
+	windowedWidth  int32
+	windowedHeight int32
 }
 
 // NewRenderer creates a new raylib renderer with basic settings.
@@ -32,12 +72,14 @@ func NewRenderer(screenWidth, screenHeight int32) *Renderer {
 	}
 
 	return &Renderer{
-		ScreenWidth:  screenWidth,
-		ScreenHeight: screenHeight,
-		RenderWidth:  screenWidth,
-		RenderHeight: screenHeight,
-		camera:       camera,
-		useRenderTex: false,
+		ScreenWidth:    screenWidth,
+		ScreenHeight:   screenHeight,
+		RenderWidth:    screenWidth,
+		RenderHeight:   screenHeight,
+		camera:         camera,
+		useRenderTex:   false,
+		ClearColor:     core.ColorBlack,
+		LetterboxColor: core.ColorBlack,
 	}
 }
 
@@ -45,11 +87,8 @@ func NewRenderer(screenWidth, screenHeight int32) *Renderer {
 // This handles window creation, maximization, and render texture setup.
 func NewRendererWithConfig(config core.DisplayConfig) *Renderer {
 	// Set window flags before creation
-	if config.Resizable {
-		rl.SetConfigFlags(rl.FlagWindowResizable)
-	}
-	if config.VSync {
-		rl.SetConfigFlags(rl.FlagVsyncHint)
+	if flags := configFlags(config); flags != 0 {
+		rl.SetConfigFlags(flags)
 	}
 
 	// Create window
@@ -60,6 +99,13 @@ func NewRendererWithConfig(config core.DisplayConfig) *Renderer {
 		rl.MaximizeWindow()
 	}
 
+	// Fullscreen takes precedence over borderless
+	if config.Fullscreen {
+		enterFullscreen()
+	} else if config.Borderless {
+		rl.ToggleBorderlessWindowed()
+	}
+
 	// Set target FPS
 	if config.TargetFPS > 0 {
 		rl.SetTargetFPS(config.TargetFPS)
@@ -81,12 +127,16 @@ func NewRendererWithConfig(config core.DisplayConfig) *Renderer {
 	useRenderTex := config.RenderWidth > 0 && config.RenderHeight > 0
 
 	r := &Renderer{
-		ScreenWidth:  int32(rl.GetScreenWidth()),
-		ScreenHeight: int32(rl.GetScreenHeight()),
-		RenderWidth:  renderW,
-		RenderHeight: renderH,
-		camera:       camera,
-		useRenderTex: useRenderTex,
+		ScreenWidth:    int32(rl.GetScreenWidth()),
+		ScreenHeight:   int32(rl.GetScreenHeight()),
+		RenderWidth:    renderW,
+		RenderHeight:   renderH,
+		camera:         camera,
+		useRenderTex:   useRenderTex,
+		windowedWidth:  config.WindowWidth,
+		windowedHeight: config.WindowHeight,
+		ClearColor:     core.ColorBlack,
+		LetterboxColor: core.ColorBlack,
 	}
 
 	// Create render texture if using fixed resolution
@@ -97,6 +147,23 @@ func NewRendererWithConfig(config core.DisplayConfig) *Renderer {
 	return r
 }
 
+// configFlags returns the raylib window flags requested by config.
+func configFlags(config core.DisplayConfig) uint32 {
+	var flags uint32
+	if config.Resizable {
+		flags |= rl.FlagWindowResizable
+	}
+	if config.VSync {
+		flags |= rl.FlagVsyncHint
+	}
+	// raylib can only request 4x multisampling, so any sample count asks
+	// for that
+	if config.MSAASamples > 0 {
+		flags |= rl.FlagMsaa4xHint
+	}
+	return flags
+}
+
 // Close releases renderer resources.
 func (r *Renderer) Close() {
 	if r.useRenderTex {
@@ -106,28 +173,89 @@ func (r *Renderer) Close() {
 
 // HandleResize updates renderer when window is resized.
 func (r *Renderer) HandleResize() {
-	newW := int32(rl.GetScreenWidth())
-	newH := int32(rl.GetScreenHeight())
-	if newW != r.ScreenWidth || newH != r.ScreenHeight {
-		r.ScreenWidth = newW
-		r.ScreenHeight = newH
+	r.resize(int32(rl.GetScreenWidth()), int32(rl.GetScreenHeight()))
+}
+
+// resize records a new window size, so the render texture blit and screen
+// picking use it.
+func (r *Renderer) resize(width, height int32) {
+	if width != r.ScreenWidth || height != r.ScreenHeight {
+		r.ScreenWidth = width
+		r.ScreenHeight = height
 		r.windowResized = true
 	}
 }
 
+// ToggleFullscreen switches between a window and exclusive fullscreen at
+// the monitor's resolution, e.g. on F11. Leaving fullscreen restores the
+// previous window size. The render texture keeps its resolution and is
+// letterboxed to the new screen size.
+func (r *Renderer) ToggleFullscreen() {
+	if rl.IsWindowFullscreen() {
+		rl.ToggleFullscreen()
+		rl.SetWindowSize(int(r.windowedWidth), int(r.windowedHeight))
+	} else {
+		r.windowedWidth = int32(rl.GetScreenWidth())
+		r.windowedHeight = int32(rl.GetScreenHeight())
+		enterFullscreen()
+	}
+	r.HandleResize()
+}
+
+// ToggleBorderless switches between a window and a borderless window
+// covering the monitor.
+func (r *Renderer) ToggleBorderless() {
+	rl.ToggleBorderlessWindowed()
+	r.HandleResize()
+}
+
+// IsFullscreen reports whether the window is in exclusive fullscreen.
+func (r *Renderer) IsFullscreen() bool {
+	return rl.IsWindowFullscreen()
+}
+
+// enterFullscreen sizes the window to its monitor and makes it fullscreen;
+// raylib keeps the window's size as the fullscreen resolution.
+func enterFullscreen() {
+	monitor := rl.GetCurrentMonitor()
+	rl.SetWindowSize(rl.GetMonitorWidth(monitor), rl.GetMonitorHeight(monitor))
+	rl.ToggleFullscreen()
+}
+
 // BeginFrame begins a new frame.
 func (r *Renderer) BeginFrame() {
 	r.HandleResize()
 
 	if r.useRenderTex {
 		rl.BeginTextureMode(r.renderTarget)
-		rl.ClearBackground(rl.Black)
+		r.clear()
 	} else {
 		rl.BeginDrawing()
-		rl.ClearBackground(rl.Black)
+		r.clear()
 	}
 }
 
+// clearBackground fills the current render target, swappable so tests can
+// check the clear color without a GL context.
+var clearBackground = rl.ClearBackground
+
+// clear fills the current render target with ClearColor.
+func (r *Renderer) clear() {
+	clearBackground(coreToRlColor(r.ClearColor))
+}
+
+// clearLetterbox fills the window with LetterboxColor before the render
+// texture is drawn over it.
+func (r *Renderer) clearLetterbox() {
+	clearBackground(coreToRlColor(r.LetterboxColor))
+}
+
+// SetClearColor sets the color frames are cleared to, such as a Scene's
+// BackgroundColor.
+func (r *Renderer) SetClearColor(color core.Color) {
+	r.ClearColor = color
+}
+
 // End3DAndBlit ends 3D rendering and blits the render texture if used.
 // After this, you can draw 2D overlays directly to the screen.
 func (r *Renderer) End3DAndBlit() {
@@ -140,7 +268,7 @@ func (r *Renderer) End3DAndBlit() {
 
 		// Draw render texture scaled to window
 		rl.BeginDrawing()
-		rl.ClearBackground(rl.Black)
+		r.clearLetterbox()
 
 		// Calculate scaling to fit window while maintaining aspect ratio
 		srcRect := rl.Rectangle{
@@ -150,22 +278,7 @@ func (r *Renderer) End3DAndBlit() {
 			Height: -float32(r.RenderHeight), // Negative to flip Y
 		}
 
-		// Scale to fit window
-		scale := min(
-			float32(r.ScreenWidth)/float32(r.RenderWidth),
-			float32(r.ScreenHeight)/float32(r.RenderHeight),
-		)
-		destW := float32(r.RenderWidth) * scale
-		destH := float32(r.RenderHeight) * scale
-		destX := (float32(r.ScreenWidth) - destW) / 2
-		destY := (float32(r.ScreenHeight) - destH) / 2
-
-		destRect := rl.Rectangle{
-			X:      destX,
-			Y:      destY,
-			Width:  destW,
-			Height: destH,
-		}
+		destRect := r.viewport()
 
 		rl.DrawTexturePro(r.renderTarget.Texture, srcRect, destRect, rl.Vector2{}, 0, rl.White)
 		// Don't EndDrawing yet - allow screen overlays
@@ -173,25 +286,121 @@ func (r *Renderer) End3DAndBlit() {
 	// If not using render tex, we're already in drawing mode
 }
 
+// SetScaleMode sets how the render texture is scaled to the window. Integer
+// scaling also switches the texture to nearest-neighbor filtering.
+func (r *Renderer) SetScaleMode(mode ScaleMode) {
+	r.scaleMode = mode
+	if mode == ScaleInteger && r.useRenderTex {
+		rl.SetTextureFilter(r.renderTarget.Texture, rl.FilterPoint)
+	}
+}
+
+// integerScale returns the largest whole factor by which a render texture
+// fits in the screen, at least 1.
+func integerScale(screenWidth, screenHeight, renderWidth, renderHeight int32) int32 {
+	if renderWidth <= 0 || renderHeight <= 0 {
+		return 1
+	}
+	return max(1, min(screenWidth/renderWidth, screenHeight/renderHeight))
+}
+
+// viewport returns the window area the 3D scene is shown in. With a render
+// texture this is the render resolution scaled to fit the window while
+// maintaining aspect ratio, by a whole factor in ScaleInteger mode;
+// otherwise it is the whole window.
+func (r *Renderer) viewport() rl.Rectangle {
+	if !r.useRenderTex {
+		return rl.Rectangle{Width: float32(r.ScreenWidth), Height: float32(r.ScreenHeight)}
+	}
+
+	scale := min(
+		float32(r.ScreenWidth)/float32(r.RenderWidth),
+		float32(r.ScreenHeight)/float32(r.RenderHeight),
+	)
+	if r.scaleMode == ScaleInteger {
+		scale = float32(integerScale(r.ScreenWidth, r.ScreenHeight, r.RenderWidth, r.RenderHeight))
+	}
+	destW := float32(r.RenderWidth) * scale
+	destH := float32(r.RenderHeight) * scale
+
+	return rl.Rectangle{
+		X:      (float32(r.ScreenWidth) - destW) / 2,
+		Y:      (float32(r.ScreenHeight) - destH) / 2,
+		Width:  destW,
+		Height: destH,
+	}
+}
+
+// ScreenToWorldXZ casts a ray from the current camera through the window
+// pixel (mouseX, mouseY) and returns where it meets the Y=0 plane that hex
+// grids are drawn on. The hit's world X and Z are the pixel X and Y that
+// HexLayout.FromPixel and the hex hit testers expect.
+// Returns false when the pixel is outside the letterboxed scene, the ray
+// runs parallel to the plane, or the plane is behind the camera.
+func (r *Renderer) ScreenToWorldXZ(mouseX, mouseY int32) (core.Vec3, bool) {
+	view := r.viewport()
+	if view.Width <= 0 || view.Height <= 0 {
+		return core.Vec3{}, false
+	}
+
+	px := float32(mouseX) - view.X
+	py := float32(mouseY) - view.Y
+	if px < 0 || py < 0 || px > view.Width || py > view.Height {
+		return core.Vec3{}, false
+	}
+
+	// Window Y grows downward; device coordinates grow upward
+	ndcX := 2*px/view.Width - 1
+	ndcY := 1 - 2*py/view.Height
+
+	ray := rlToCoreCamera(r.camera).ScreenRay(ndcX, ndcY, view.Width/view.Height)
+	return ray.IntersectPlaneY(0)
+}
+
 // EndFrame ends the current frame. Call after all drawing is complete.
 func (r *Renderer) EndFrame() {
 	rl.EndDrawing()
 }
 
 // Begin3D begins 3D rendering with the specified camera.
+// If target bounds are set, the camera target is clamped to them, and if a
+// projection is set with SetProjection, the camera is switched to it.
 func (r *Renderer) Begin3D(camera core.Camera) {
+	if r.targetBounded {
+		camera = camera.ClampTarget(r.targetMin, r.targetMax)
+	}
+	if r.projectionSet {
+		camera = camera.WithProjection(r.projection)
+	}
 	r.camera = coreToRlCamera(camera)
+	viewPosition = r.camera.Position
+	viewCamera = camera
+	viewFrustum, viewFrustumSet = camera.Frustum(r.aspect()), true
 	rl.BeginMode3D(r.camera)
 }
 
 // End3D ends 3D rendering.
 func (r *Renderer) End3D() {
+	viewFrustumSet = false
 	rl.EndMode3D()
 }
 
-// DrawLine3D draws a 3D line.
+// aspect returns the width over height of the target 3D drawing goes to:
+// the render texture if used, otherwise the window.
+func (r *Renderer) aspect() float32 {
+	width, height := r.ScreenWidth, r.ScreenHeight
+	if r.useRenderTex {
+		width, height = r.RenderWidth, r.RenderHeight
+	}
+	if height <= 0 {
+		return 1
+	}
+	return float32(width) / float32(height)
+}
+
+// DrawLine3D draws a 3D line, LineThickness units wide.
 func (r *Renderer) DrawLine3D(start, end core.Vec3, color core.Color) {
-	rl.DrawLine3D(coreToRlVec3(start), coreToRlVec3(end), coreToRlColor(color))
+	drawLine3D(coreToRlVec3(start), coreToRlVec3(end), r.LineThickness, coreToRlColor(color))
 }
 
 // DrawTriangle3D draws a 3D triangle.
@@ -199,6 +408,40 @@ func (r *Renderer) DrawTriangle3D(v1, v2, v3 core.Vec3, color core.Color) {
 	rl.DrawTriangle3D(coreToRlVec3(v1), coreToRlVec3(v2), coreToRlVec3(v3), coreToRlColor(color))
 }
 
+// DrawPolygon3D fills a convex polygon as a fan of triangles. Polygons
+// with fewer than 3 vertices draw nothing.
+func (r *Renderer) DrawPolygon3D(vertices []core.Vec3, color core.Color) {
+	for _, tri := range core.FanTriangles(len(vertices)) {
+		r.DrawTriangle3D(vertices[tri[0]], vertices[tri[1]], vertices[tri[2]], color)
+	}
+}
+
+// DrawPolygonLines3D draws a polygon's closed outline.
+func (r *Renderer) DrawPolygonLines3D(vertices []core.Vec3, color core.Color) {
+	for _, edge := range core.PolygonEdges(len(vertices)) {
+		r.DrawLine3D(vertices[edge[0]], vertices[edge[1]], color)
+	}
+}
+
+// DrawCircle3D draws a circle outline as a loop of segments lines in the
+// plane facing normal. A zero normal draws on the XZ plane.
+func (r *Renderer) DrawCircle3D(center core.Vec3, radius float32, normal core.Vec3, segments int, color core.Color) {
+	points := core.CirclePoints(center, radius, normal, segments)
+	for i := range points {
+		r.DrawLine3D(points[i], points[(i+1)%len(points)], color)
+	}
+}
+
+// DrawArc3D draws an arc from startAngle to endAngle (degrees) as segments
+// lines in the plane facing normal. A zero normal draws on the XZ plane,
+// with angle 0 along +X.
+func (r *Renderer) DrawArc3D(center core.Vec3, radius, startAngle, endAngle float32, normal core.Vec3, segments int, color core.Color) {
+	points := core.ArcPoints(center, radius, startAngle, endAngle, normal, segments)
+	for i := 1; i < len(points); i++ {
+		r.DrawLine3D(points[i-1], points[i], color)
+	}
+}
+
 // DrawGrid draws a reference grid.
 func (r *Renderer) DrawGrid(slices int, spacing float32) {
 	rl.DrawGrid(int32(slices), spacing)
@@ -214,6 +457,25 @@ func (r *Renderer) DrawText2D(text string, x, y int32, fontSize int32, color cor
 	rl.DrawText(text, x, y, fontSize, coreToRlColor(color))
 }
 
+// raylib's 2D rectangle calls, swappable so tests can check what is drawn
+// without a window.
+var (
+	drawRectangleRec     = rl.DrawRectangleRec
+	drawRectangleLinesEx = rl.DrawRectangleLinesEx
+)
+
+// DrawRect2D draws a filled screen-space rectangle.
+// Call between End3DAndBlit (or End3D) and EndFrame to draw over the scene.
+func (r *Renderer) DrawRect2D(x, y, w, h float32, color core.Color) {
+	drawRectangleRec(rl.Rectangle{X: x, Y: y, Width: w, Height: h}, coreToRlColor(color))
+}
+
+// DrawRectOutline2D draws the outline of a screen-space rectangle with the
+// given line thickness. The outline is drawn inside the rectangle bounds.
+func (r *Renderer) DrawRectOutline2D(x, y, w, h, thickness float32, color core.Color) {
+	drawRectangleLinesEx(rl.Rectangle{X: x, Y: y, Width: w, Height: h}, thickness, coreToRlColor(color))
+}
+
 // GetScreenWidth returns the screen width.
 func (r *Renderer) GetScreenWidth() int32 {
 	return r.ScreenWidth
@@ -233,3 +495,45 @@ func (r *Renderer) GetCamera() rl.Camera3D {
 func (r *Renderer) SetCamera(camera rl.Camera3D) {
 	r.camera = camera
 }
+
+// ClampTargetToBounds keeps the camera target within the box between min and
+// max, e.g. a grid's world bounds plus a margin. The current camera is clamped
+// immediately, and later pans and Begin3D calls are clamped too.
+func (r *Renderer) ClampTargetToBounds(min, max core.Vec3) {
+	r.targetBounded = true
+	r.targetMin = min
+	r.targetMax = max
+	r.camera = coreToRlCamera(rlToCoreCamera(r.camera).ClampTarget(min, max))
+}
+
+// ClearTargetBounds removes any bounds set by ClampTargetToBounds.
+func (r *Renderer) ClearTargetBounds() {
+	r.targetBounded = false
+}
+
+// SetProjection switches the current camera, and the cameras of later
+// Begin3D calls, to core.CameraPerspective or core.CameraOrthographic.
+// Fovy is converted so the view at the camera target keeps its size; see
+// core.Camera.WithProjection.
+func (r *Renderer) SetProjection(mode int) {
+	r.projectionSet = true
+	r.projection = mode
+	r.camera = coreToRlCamera(rlToCoreCamera(r.camera).WithProjection(mode))
+}
+
+// ClearProjection removes any projection set by SetProjection, so later
+// Begin3D calls use their camera's own projection.
+func (r *Renderer) ClearProjection() {
+	r.projectionSet = false
+}
+
+// PanCamera moves the current camera position and target by delta,
+// respecting any target bounds, and returns the resulting camera.
+func (r *Renderer) PanCamera(delta core.Vec3) core.Camera {
+	camera := rlToCoreCamera(r.camera).Pan(delta)
+	if r.targetBounded {
+		camera = camera.ClampTarget(r.targetMin, r.targetMax)
+	}
+	r.camera = coreToRlCamera(camera)
+	return camera
+}
@@ -0,0 +1,168 @@
+package raylib
+
+import (
+	"math"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"github.com/chazu/spectrex/core"
+)
+
+func TestRenderer_ViewportAfterResize(t *testing.T) {
+	// A fixed 320x180 render texture shown in a window, then in fullscreen
+	// on monitors of various shapes
+	tests := []struct {
+		name          string
+		width, height int32
+		want          rl.Rectangle
+	}{
+		{"16:9 window", 1280, 720, rl.Rectangle{X: 0, Y: 0, Width: 1280, Height: 720}},
+		{"16:10 fullscreen", 1920, 1200, rl.Rectangle{X: 0, Y: 60, Width: 1920, Height: 1080}},
+		{"ultrawide fullscreen", 3440, 1440, rl.Rectangle{X: 440, Y: 0, Width: 2560, Height: 1440}},
+		{"4:3 fullscreen", 1024, 768, rl.Rectangle{X: 0, Y: 96, Width: 1024, Height: 576}},
+	}
+
+	r := &Renderer{RenderWidth: 320, RenderHeight: 180, useRenderTex: true}
+	for _, tt := range tests {
+		r.resize(tt.width, tt.height)
+		if r.ScreenWidth != tt.width || r.ScreenHeight != tt.height {
+			t.Errorf("%s: screen size = %dx%d, want %dx%d", tt.name, r.ScreenWidth, r.ScreenHeight, tt.width, tt.height)
+		}
+		if got := r.viewport(); !rectNear(got, tt.want) {
+			t.Errorf("%s: viewport = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+
+	// Without a render texture the scene fills the whole screen
+	r.useRenderTex = false
+	r.resize(1920, 1200)
+	if got, want := r.viewport(), (rl.Rectangle{Width: 1920, Height: 1200}); got != want {
+		t.Errorf("window viewport = %+v, want %+v", got, want)
+	}
+}
+
+// rectNear reports whether two rectangles match to within float error.
+func rectNear(a, b rl.Rectangle) bool {
+	const eps = 1e-3
+	return math.Abs(float64(a.X-b.X)) < eps &&
+		math.Abs(float64(a.Y-b.Y)) < eps &&
+		math.Abs(float64(a.Width-b.Width)) < eps &&
+		math.Abs(float64(a.Height-b.Height)) < eps
+}
+
+func TestConfigFlags(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*core.DisplayConfig)
+		want   uint32
+	}{
+		{"defaults", func(c *core.DisplayConfig) {}, rl.FlagWindowResizable | rl.FlagVsyncHint},
+		{"none", func(c *core.DisplayConfig) { c.Resizable, c.VSync = false, false }, 0},
+		{"msaa 2x", func(c *core.DisplayConfig) { c.MSAASamples = 2 }, rl.FlagWindowResizable | rl.FlagVsyncHint | rl.FlagMsaa4xHint},
+		{"msaa 8x only", func(c *core.DisplayConfig) {
+			c.Resizable, c.VSync, c.MSAASamples = false, false, 8
+		}, rl.FlagMsaa4xHint},
+	}
+
+	for _, tt := range tests {
+		config := core.DefaultDisplayConfig()
+		tt.modify(&config)
+		if got := configFlags(config); got != tt.want {
+			t.Errorf("%s: flags = %#x, want %#x", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRenderer_ClearColor(t *testing.T) {
+	var cleared []rl.Color
+	saved := clearBackground
+	clearBackground = func(color rl.Color) { cleared = append(cleared, color) }
+	defer func() { clearBackground = saved }()
+
+	r := NewRenderer(320, 180)
+	r.clear()
+	r.SetClearColor(core.ColorSkyBlue)
+	r.clear()
+
+	want := []rl.Color{coreToRlColor(core.ColorBlack), coreToRlColor(core.ColorSkyBlue)}
+	if len(cleared) != len(want) || cleared[0] != want[0] || cleared[1] != want[1] {
+		t.Errorf("cleared with %v, want %v", cleared, want)
+	}
+}
+
+func TestRenderer_LetterboxColor(t *testing.T) {
+	var cleared []rl.Color
+	saved := clearBackground
+	clearBackground = func(color rl.Color) { cleared = append(cleared, color) }
+	defer func() { clearBackground = saved }()
+
+	r := NewRenderer(320, 180)
+	r.SetClearColor(core.ColorSkyBlue)
+	r.LetterboxColor = core.ColorOrange
+	r.clear()
+	r.clearLetterbox()
+
+	want := []rl.Color{coreToRlColor(core.ColorSkyBlue), coreToRlColor(core.ColorOrange)}
+	if len(cleared) != len(want) || cleared[0] != want[0] || cleared[1] != want[1] {
+		t.Errorf("cleared with %v, want scene %v then letterbox %v", cleared, want[0], want[1])
+	}
+}
+
+func TestRenderer_DrawRect2D(t *testing.T) {
+	type call struct {
+		rec       rl.Rectangle
+		thickness float32
+		color     rl.Color
+	}
+	var filled, outlined []call
+	savedRec, savedLines := drawRectangleRec, drawRectangleLinesEx
+	drawRectangleRec = func(rec rl.Rectangle, color rl.Color) {
+		filled = append(filled, call{rec: rec, color: color})
+	}
+	drawRectangleLinesEx = func(rec rl.Rectangle, thickness float32, color rl.Color) {
+		outlined = append(outlined, call{rec: rec, thickness: thickness, color: color})
+	}
+	defer func() { drawRectangleRec, drawRectangleLinesEx = savedRec, savedLines }()
+
+	r := NewRenderer(320, 180)
+	r.DrawRect2D(10, 20, 30, 40, core.ColorOrange)
+	r.DrawRectOutline2D(5, 6, 70, 80, 2.5, core.ColorSkyBlue)
+
+	wantFill := call{rec: rl.Rectangle{X: 10, Y: 20, Width: 30, Height: 40}, color: coreToRlColor(core.ColorOrange)}
+	if len(filled) != 1 || filled[0] != wantFill {
+		t.Errorf("DrawRect2D drew %+v, want %+v", filled, wantFill)
+	}
+	wantOutline := call{rec: rl.Rectangle{X: 5, Y: 6, Width: 70, Height: 80}, thickness: 2.5, color: coreToRlColor(core.ColorSkyBlue)}
+	if len(outlined) != 1 || outlined[0] != wantOutline {
+		t.Errorf("DrawRectOutline2D drew %+v, want %+v", outlined, wantOutline)
+	}
+}
+
+func TestIntegerScale(t *testing.T) {
+	tests := []struct {
+		name             string
+		screenW, screenH int32
+		renderW, renderH int32
+		want             int32
+	}{
+		{"exact 4x", 1280, 720, 320, 180, 4},
+		{"fractional fit rounds down", 1920, 1080, 320, 200, 5},
+		{"limited by height", 3440, 1440, 320, 180, 8},
+		{"limited by width", 1000, 2000, 320, 180, 3},
+		{"window smaller than texture", 200, 100, 320, 180, 1},
+	}
+
+	for _, tt := range tests {
+		if got := integerScale(tt.screenW, tt.screenH, tt.renderW, tt.renderH); got != tt.want {
+			t.Errorf("%s: integerScale = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+
+	// The viewport is centered at the whole-number scale
+	r := &Renderer{RenderWidth: 320, RenderHeight: 180, useRenderTex: true, scaleMode: ScaleInteger}
+	r.resize(2000, 1200)
+	if got, want := r.viewport(), (rl.Rectangle{X: 40, Y: 60, Width: 1920, Height: 1080}); !rectNear(got, want) {
+		t.Errorf("integer viewport = %+v, want %+v", got, want)
+	}
+}
@@ -3,6 +3,7 @@ package raylib
 
 import (
 	"strings"
+	"unicode/utf8"
 
 	rl "github.com/gen2brain/raylib-go/raylib"
 
@@ -12,6 +13,18 @@ import (
 // TextScreenRenderer implements core.TextScreenRenderer using raylib.
 type TextScreenRenderer struct {
 	fontRenderer *FontRenderer
+
+	// LineThickness is the world-space width of glyph strokes.
+	// 0 draws raylib's 1px lines.
+	LineThickness float32
+
+	// camera is the viewpoint billboarded screens turn to face and fills
+	// are wound toward, once set by SetCamera; see view
+	camera    core.Camera
+	cameraSet bool
+
+	// runes is drawLine's scratch buffer, reused across lines and frames
+	runes []rune
 }
 
 // NewTextScreenRenderer creates a new raylib text screen renderer.
@@ -24,6 +37,9 @@ func NewTextScreenRenderer() *TextScreenRenderer {
 // DrawTextScreen renders a complete text screen with all its regions.
 func (tsr *TextScreenRenderer) DrawTextScreen(screen *core.TextScreen) {
 	model := tsr.calculateTransform(screen)
+	if !screenInView(screen, model) {
+		return
+	}
 
 	// Draw background if not transparent
 	if !screen.Transparent {
@@ -32,7 +48,7 @@ func (tsr *TextScreenRenderer) DrawTextScreen(screen *core.TextScreen) {
 
 	// Draw border if enabled
 	if screen.ShowBorder || screen.Debug {
-		tsr.drawScreenBorder(screen, model)
+		tsr.drawScreenBorder(screen, offsetZ(model, screen.BorderZOffset))
 	}
 
 	// Draw all regions
@@ -41,6 +57,33 @@ func (tsr *TextScreenRenderer) DrawTextScreen(screen *core.TextScreen) {
 	}
 }
 
+// SetCamera sets the camera that billboarded screens turn to face and
+// backgrounds are wound toward. Call it each frame before DrawTextScreen
+// if screens are drawn for a camera other than the Renderer's;
+// DrawTextScreens sets it itself.
+func (tsr *TextScreenRenderer) SetCamera(camera core.Camera) {
+	tsr.camera = camera
+	tsr.cameraSet = true
+}
+
+// view returns the camera screens are drawn for: the one given to
+// SetCamera, or until then the camera of the current Renderer.Begin3D.
+func (tsr *TextScreenRenderer) view() core.Camera {
+	if tsr.cameraSet {
+		return tsr.camera
+	}
+	return viewCamera
+}
+
+// DrawTextScreens renders several screens sorted by depth from the camera,
+// so transparent screens blend correctly over the screens behind them.
+func (tsr *TextScreenRenderer) DrawTextScreens(screens []*core.TextScreen, camera core.Camera) {
+	tsr.SetCamera(camera)
+	for _, screen := range core.SortScreensByDepth(screens, camera.Position) {
+		tsr.DrawTextScreen(screen)
+	}
+}
+
 // DrawTextRegion renders a single text region.
 func (tsr *TextScreenRenderer) DrawTextRegion(region *core.TextRegion, screenTransform rl.Matrix, screenScale float32) {
 	// Draw background if not transparent
@@ -50,7 +93,7 @@ func (tsr *TextScreenRenderer) DrawTextRegion(region *core.TextRegion, screenTra
 
 	// Draw border if enabled
 	if region.ShowBorder || region.Parent.Debug {
-		tsr.drawRegionBorder(region, screenTransform)
+		tsr.drawRegionBorder(region, offsetZ(screenTransform, region.Parent.BorderZOffset))
 	}
 
 	// Skip text rendering if no text or font
@@ -59,60 +102,93 @@ func (tsr *TextScreenRenderer) DrawTextRegion(region *core.TextRegion, screenTra
 	}
 
 	effectiveScale := region.Scale * screenScale
-	lines := region.GetLines()
+	lines, offsets := region.GetLinesWithOffsets()
 	if len(lines) == 0 {
 		return
 	}
 
 	totalTextHeight := region.CalculateTextHeight(lines)
-	startY := region.CalculateStartY(totalTextHeight)
+	// Scrolling moves the text up (+Y) so later lines enter the region
+	startY := region.CalculateStartY(totalTextHeight) + region.ScrollOffset
 	lineHeight := float32(region.Font.Height) * effectiveScale
+	innerX, _, innerWidth, _ := region.InnerRect()
+	// Text sits just in front of the backgrounds so it does not z-fight
+	textTransform := offsetZ(screenTransform, region.Parent.TextZOffset)
+	boxes := region.UseTextBoxesFrom(tsr.view(), rlToCoreMatrix(textTransform), float32(rl.GetScreenHeight()))
 
 	for i, line := range lines {
 		// Subtract Y because in 3D space Y increases upward, but text flows downward
 		yPos := startY - float32(i)*lineHeight*region.LineSpacing
 
-		if yPos < region.Y || yPos > region.Y+region.Height {
+		if !region.LineVisible(yPos) {
 			continue
 		}
 
 		// Handle truncation
 		if region.TruncateOverflow && region.HAlign != core.AlignJustified {
 			lineWidth := region.CalculateLineWidth(line, effectiveScale)
-			if lineWidth > region.Width {
+			if lineWidth > innerWidth {
 				if !strings.HasSuffix(line, region.OverflowMarker) {
 					markerWidth := region.CalculateLineWidth(region.OverflowMarker, effectiveScale)
-					line = region.TruncateLineToFit(line, region.Width-markerWidth, effectiveScale) + region.OverflowMarker
+					line = region.TruncateLineToFit(line, innerWidth-markerWidth, effectiveScale) + region.OverflowMarker
 				}
 			}
 		}
 
+		if boxes {
+			tsr.drawLineBox(region, line, yPos, region.IsJustified(line, i, len(lines)), textTransform)
+			continue
+		}
+
+		if region.IsJustified(line, i, len(lines)) {
+			tsr.drawJustifiedLine(region, line, offsets[i], innerX+innerWidth, yPos, effectiveScale, textTransform)
+			tsr.drawDecorations(region, line, yPos, true, textTransform)
+			continue
+		}
+
 		// Calculate X position based on alignment
-		// Note: 180° Y rotation flips X axis, so local right → world left
 		lineWidth := region.CalculateLineWidth(line, effectiveScale)
-		var xPos float32
-		switch region.HAlign {
-		case core.AlignLeft:
-			// Start from local right edge (becomes world left after transform)
-			xPos = region.X + region.Width
-		case core.AlignCenter:
-			// Center the text
-			xPos = region.X + (region.Width+lineWidth)/2
-		case core.AlignRight:
-			// End at local left edge (becomes world right after transform)
-			xPos = region.X + lineWidth
-		case core.AlignJustified:
-			if i < len(lines)-1 && strings.Contains(line, " ") {
-				tsr.drawJustifiedLine(region, line, region.X+region.Width, yPos, effectiveScale, screenTransform)
-				continue
-			}
-			xPos = region.X + region.Width
-		}
+		xPos := region.CalculateLineX(lineWidth)
+
+		tsr.drawLine(region, line, offsets[i], rl.Vector3{X: xPos, Y: yPos, Z: 0}, effectiveScale, textTransform)
+		tsr.drawDecorations(region, line, yPos, false, textTransform)
+	}
+}
+
+// drawLineBox draws the solid box standing in for a line drawn at y when
+// the region is simplified for distance. Justified lines span the full
+// inner width.
+func (tsr *TextScreenRenderer) drawLineBox(region *core.TextRegion, line string, y float32, justified bool, transform rl.Matrix) {
+	box, ok := region.LineBox(line, y)
+	if !ok {
+		return
+	}
+	if justified {
+		box.X, _, box.Width, _ = region.InnerRect()
+	}
 
-		pos := rl.Vector3{X: xPos, Y: yPos, Z: 0}
-		transformedPos := rl.Vector3Transform(pos, screenTransform)
+	bottomLeft := rl.Vector3Transform(rl.Vector3{X: box.X, Y: box.Y, Z: 0}, transform)
+	bottomRight := rl.Vector3Transform(rl.Vector3{X: box.X + box.Width, Y: box.Y, Z: 0}, transform)
+	topRight := rl.Vector3Transform(rl.Vector3{X: box.X + box.Width, Y: box.Y + box.Height, Z: 0}, transform)
+	topLeft := rl.Vector3Transform(rl.Vector3{X: box.X, Y: box.Y + box.Height, Z: 0}, transform)
 
-		tsr.drawLine(region, line, transformedPos, effectiveScale)
+	tsr.fillQuad([4]rl.Vector3{bottomLeft, bottomRight, topRight, topLeft}, coreToRlColor(region.Color))
+}
+
+// drawDecorations draws the region's underline and strikethrough for a line
+// drawn at y. Justified lines are stretched to the full inner
+// width, so their decorations span it too.
+func (tsr *TextScreenRenderer) drawDecorations(region *core.TextRegion, line string, y float32, justified bool, transform rl.Matrix) {
+	color := coreToRlColor(region.Color)
+	innerX, _, innerWidth, _ := region.InnerRect()
+	for _, d := range region.LineDecorations(line, y) {
+		if justified {
+			d.StartX = innerX + innerWidth
+			d.EndX = innerX
+		}
+		start := rl.Vector3Transform(rl.Vector3{X: d.StartX, Y: d.Y, Z: 0}, transform)
+		end := rl.Vector3Transform(rl.Vector3{X: d.EndX, Y: d.Y, Z: 0}, transform)
+		drawLine3D(start, end, tsr.LineThickness, color)
 	}
 }
 
@@ -127,13 +203,10 @@ func (tsr *TextScreenRenderer) DrawTextDocument(doc *core.TextDocument) {
 	}
 }
 
+// calculateTransform returns the screen's transform as seen from the
+// camera, taken from core so every backend places screens the same way.
 func (tsr *TextScreenRenderer) calculateTransform(screen *core.TextScreen) rl.Matrix {
-	model := rl.MatrixIdentity()
-	model = rl.MatrixRotateX(core.DegToRad(screen.Rotation.X))
-	model = rl.MatrixMultiply(model, rl.MatrixRotateY(core.DegToRad(screen.Rotation.Y+180.0)))
-	model = rl.MatrixMultiply(model, rl.MatrixRotateZ(core.DegToRad(screen.Rotation.Z)))
-	model = rl.MatrixMultiply(model, rl.MatrixTranslate(screen.Position.X, screen.Position.Y, screen.Position.Z))
-	return model
+	return coreToRlMatrix(screen.GetViewTransformMatrix(tsr.view().Position))
 }
 
 func (tsr *TextScreenRenderer) drawScreenBackground(screen *core.TextScreen, transform rl.Matrix) {
@@ -143,8 +216,52 @@ func (tsr *TextScreenRenderer) drawScreenBackground(screen *core.TextScreen, tra
 	bottomLeft := rl.Vector3Transform(rl.Vector3{X: 0, Y: screen.Height, Z: 0}, transform)
 
 	bgColor := coreToRlColor(screen.BackgroundColor)
-	rl.DrawTriangle3D(topLeft, topRight, bottomRight, bgColor)
-	rl.DrawTriangle3D(topLeft, bottomRight, bottomLeft, bgColor)
+	switch screen.BackgroundMode {
+	case core.BackgroundWireframe:
+		rl.DrawLine3D(topLeft, topRight, bgColor)
+		rl.DrawLine3D(topRight, bottomRight, bgColor)
+		rl.DrawLine3D(bottomRight, bottomLeft, bgColor)
+		rl.DrawLine3D(bottomLeft, topLeft, bgColor)
+		rl.DrawLine3D(topLeft, bottomRight, bgColor)
+	case core.BackgroundScanlines:
+		for _, y := range screen.Scanlines() {
+			start := rl.Vector3Transform(rl.Vector3{X: 0, Y: y, Z: 0}, transform)
+			end := rl.Vector3Transform(rl.Vector3{X: screen.Width, Y: y, Z: 0}, transform)
+			rl.DrawLine3D(start, end, bgColor)
+		}
+	default:
+		tsr.fillQuad([4]rl.Vector3{topLeft, topRight, bottomRight, bottomLeft}, bgColor)
+	}
+}
+
+// fillQuad fills a quad with two triangles wound to face the camera, so
+// raylib's backface culling doesn't hide it when the screen is turned away.
+func (tsr *TextScreenRenderer) fillQuad(corners [4]rl.Vector3, color rl.Color) {
+	var quad [4]core.Vec3
+	for i, corner := range corners {
+		quad[i] = rlToCoreVec3(corner)
+	}
+	for _, tri := range core.QuadTriangles(quad, tsr.view().Position) {
+		rl.DrawTriangle3D(coreToRlVec3(tri[0]), coreToRlVec3(tri[1]), coreToRlVec3(tri[2]), color)
+	}
+}
+
+// screenInView reports whether any of the screen's quad, placed by
+// transform, may be visible in the current 3D mode.
+func screenInView(screen *core.TextScreen, transform rl.Matrix) bool {
+	m := rlToCoreMatrix(transform)
+	return inView([]core.Vec3{
+		m.TransformVec3(core.Vec3{}),
+		m.TransformVec3(core.Vec3{X: screen.Width}),
+		m.TransformVec3(core.Vec3{X: screen.Width, Y: screen.Height}),
+		m.TransformVec3(core.Vec3{Y: screen.Height}),
+	})
+}
+
+// offsetZ returns transform applied after moving z along the local Z
+// axis, toward the screen's readable side.
+func offsetZ(transform rl.Matrix, z float32) rl.Matrix {
+	return rl.MatrixMultiply(rl.MatrixTranslate(0, 0, z), transform)
 }
 
 func (tsr *TextScreenRenderer) drawScreenBorder(screen *core.TextScreen, transform rl.Matrix) {
@@ -170,9 +287,7 @@ func (tsr *TextScreenRenderer) drawRegionBackground(region *core.TextRegion, tra
 	bottomRight := rl.Vector3Transform(rl.Vector3{X: region.X + region.Width, Y: region.Y + region.Height, Z: 0}, transform)
 	bottomLeft := rl.Vector3Transform(rl.Vector3{X: region.X, Y: region.Y + region.Height, Z: 0}, transform)
 
-	bgColor := coreToRlColor(region.BackgroundColor)
-	rl.DrawTriangle3D(topLeft, topRight, bottomRight, bgColor)
-	rl.DrawTriangle3D(topLeft, bottomRight, bottomLeft, bgColor)
+	tsr.fillQuad([4]rl.Vector3{topLeft, topRight, bottomRight, bottomLeft}, coreToRlColor(region.BackgroundColor))
 }
 
 func (tsr *TextScreenRenderer) drawRegionBorder(region *core.TextRegion, transform rl.Matrix) {
@@ -192,57 +307,57 @@ func (tsr *TextScreenRenderer) drawRegionBorder(region *core.TextRegion, transfo
 	rl.DrawLine3D(bottomLeft, topLeft, borderColor)
 }
 
-func (tsr *TextScreenRenderer) drawLine(region *core.TextRegion, line string, position rl.Vector3, scale float32) {
-	xOffset := float32(0)
-	runes := []rune(line)
-
-	// Iterate backwards through characters to compensate for 180° Y rotation mirror effect
-	for i := len(runes) - 1; i >= 0; i-- {
-		char := runes[i]
+// lineRunes returns the runes of line in the renderer's scratch buffer,
+// which stays valid until the next call.
+func (tsr *TextScreenRenderer) lineRunes(line string) []rune {
+	tsr.runes = tsr.runes[:0]
+	for _, char := range line {
+		tsr.runes = append(tsr.runes, char)
+	}
+	return tsr.runes
+}
 
+// drawLine draws a line of text starting at position in the screen's local
+// space. startIndex is the rune index in the region's Text of the line's
+// first character, used to resolve span colors.
+func (tsr *TextScreenRenderer) drawLine(region *core.TextRegion, line string, startIndex int, position rl.Vector3, scale float32, transform rl.Matrix) {
+	offsets, lineWidth := region.VisualOffsets(line, scale)
+	charSpacing := (1.0 + region.CharSpacing) * scale
+
+	// Only the line origin is transformed; glyphs are laid out along world
+	// X from there
+	origin := rl.Vector3Transform(position, transform)
+
+	// Glyphs are placed from the far end of the line to compensate for the
+	// 180° Y rotation mirror effect; the offsets already account for the
+	// text direction
+	for i, char := range tsr.lineRunes(line) {
 		if char < 32 || char > 126 {
 			continue
 		}
-
-		glyph, exists := region.Font.Glyphs[int(char)-31]
-		if !exists {
-			xOffset += 8 * scale
+		glyph, exists := region.Font.Glyph(char)
+		if !exists || len(glyph.Strokes) == 0 {
 			continue
 		}
 
-		var glyphWidth float32
-		if glyph.RealWidth > 0 {
-			spacing := float32(glyph.RealWidth)
-			if spacing < 5 {
-				spacing = 5
-			}
-			glyphWidth = spacing * scale
-		} else {
-			glyphWidth = float32(glyph.Width) * scale
-		}
-
-		glyphPos := rl.Vector3{
-			X: position.X + xOffset + glyphWidth,
-			Y: position.Y,
-			Z: position.Z,
-		}
+		advance := lineWidth - offsets[i] - charSpacing
+		glyphPos := rl.Vector3{X: origin.X + advance, Y: origin.Y, Z: origin.Z}
 
-		tsr.drawGlyph(region.Font, int(char), glyphPos, region.Color, scale)
+		// Clipping happens in local space, where the glyph runs the other
+		// way from its origin
+		strokes := region.DashGlyphStrokes(core.GlyphStrokes(glyph, region.StrokeWeight()))
+		strokes = region.ClipGlyphStrokes(strokes, core.Vec2{X: position.X - advance, Y: position.Y}, scale)
 
-		xOffset += glyphWidth
-		xOffset += (1.0 + region.CharSpacing) * scale
+		tsr.drawGlyph(strokes, glyphPos, region.ColorAt(startIndex+i), scale, region.RoundCaps)
 	}
 }
 
-func (tsr *TextScreenRenderer) drawGlyph(font *core.HersheyFont, char int, position rl.Vector3, color core.Color, scale float32) {
-	glyph, exists := font.Glyphs[char-31]
-	if !exists || len(glyph.Strokes) == 0 {
-		return
-	}
-
+// drawGlyph draws glyph strokes at position, mirrored in X, with round
+// caps on thick strokes if roundCaps is set.
+func (tsr *TextScreenRenderer) drawGlyph(strokes []core.Stroke, position rl.Vector3, color core.Color, scale float32, roundCaps bool) {
 	rlColor := coreToRlColor(color)
 
-	for _, stroke := range glyph.Strokes {
+	for _, stroke := range strokes {
 		start := rl.Vector3{
 			X: position.X - stroke.From.X*scale,
 			Y: position.Y + stroke.From.Y*scale,
@@ -253,39 +368,55 @@ func (tsr *TextScreenRenderer) drawGlyph(font *core.HersheyFont, char int, posit
 			Y: position.Y + stroke.To.Y*scale,
 			Z: position.Z,
 		}
-		rl.DrawLine3D(start, end, rlColor)
+		drawLine3D(start, end, tsr.LineThickness, rlColor)
+	}
+
+	if !roundCaps || tsr.LineThickness <= 0 {
+		return
+	}
+	for _, p := range core.StrokeCaps(strokes) {
+		center := rl.Vector3{X: position.X - p.X*scale, Y: position.Y + p.Y*scale, Z: position.Z}
+		drawDisc3D(center, tsr.LineThickness/2, rlColor)
 	}
 }
 
-func (tsr *TextScreenRenderer) drawJustifiedLine(region *core.TextRegion, line string, x, y float32, scale float32, transform rl.Matrix) {
+func (tsr *TextScreenRenderer) drawJustifiedLine(region *core.TextRegion, line string, startIndex int, x, y float32, scale float32, transform rl.Matrix) {
 	words := strings.Split(line, " ")
 	if len(words) <= 1 {
-		pos := rl.Vector3Transform(rl.Vector3{X: x, Y: y, Z: 0}, transform)
-		tsr.drawLine(region, line, pos, scale)
+		tsr.drawLine(region, line, startIndex, rl.Vector3{X: x, Y: y, Z: 0}, scale, transform)
 		return
 	}
 
-	totalWordsWidth := float32(0)
-	for _, word := range words {
-		totalWordsWidth += region.CalculateLineWidth(word, scale)
+	// Rune index of each word within the region's Text
+	wordStarts := make([]int, len(words))
+	offset := startIndex
+	for i, word := range words {
+		wordStarts[i] = offset
+		offset += utf8.RuneCountInString(word) + 1
 	}
 
-	extraSpacePerGap := (region.Width - totalWordsWidth) / float32(len(words)-1)
-	// Start from local right edge (x is already region.X + region.Width from caller)
-	// and work leftward, placing words from last to first
-	xPos := x - region.Width
-
-	for i := len(words) - 1; i >= 0; i-- {
+	_, _, width, _ := region.InnerRect()
+	gap := region.JustifiedGap(words, scale)
+	// Start from local right edge (x is already the inner rect's right edge
+	// from caller) and work leftward, placing words from the end of a
+	// left-to-right line
+	xPos := x - width
+
+	for n := range words {
+		// Right-to-left lines place their first word at this end
+		i := len(words) - 1 - n
+		if region.Direction == core.DirectionRightToLeft {
+			i = n
+		}
 		word := words[i]
 		wordWidth := region.CalculateLineWidth(word, scale)
 		wordPos := xPos + wordWidth
 
-		pos := rl.Vector3Transform(rl.Vector3{X: wordPos, Y: y, Z: 0}, transform)
-		tsr.drawLine(region, word, pos, scale)
+		tsr.drawLine(region, word, wordStarts[i], rl.Vector3{X: wordPos, Y: y, Z: 0}, scale, transform)
 
 		xPos += wordWidth
-		if i > 0 {
-			xPos += extraSpacePerGap
+		if n < len(words)-1 {
+			xPos += gap
 		}
 	}
 }
@@ -295,60 +426,55 @@ func (tsr *TextScreenRenderer) drawSection(section *core.TextSection) {
 		return
 	}
 
-	contentFont := section.GetContentFont()
-	titleFont := section.GetTitleFont()
-
 	region := section.Region
 	screenTransform := tsr.calculateTransform(region.Parent)
 
-	if section.Title != "" && titleFont != nil {
-		titleLines := float32(len(strings.Split(section.Title, "\n")))
-		titleHeight := titleLines * float32(titleFont.Height) *
-			section.TitleStyle.Scale * section.TitleStyle.LineSpacing
-		titleGap := float32(titleFont.Height) * section.Style.Scale * 0.5
-
-		// Title region at top of section (higher Y in 3D space)
-		titleRegion := &core.TextRegion{
-			X:           region.X,
-			Y:           region.Y + region.Height - titleHeight,
-			Width:       region.Width,
-			Height:      titleHeight,
-			Text:        section.Title,
-			Font:        titleFont,
-			Color:       section.TitleStyle.Color,
-			Scale:       section.TitleStyle.Scale,
-			LineSpacing: section.TitleStyle.LineSpacing,
-			CharSpacing: section.TitleStyle.CharSpacing,
-			HAlign:      section.TitleStyle.HAlign,
-			VAlign:      section.TitleStyle.VAlign,
-			WordWrap:    true,
-			Parent:      region.Parent,
-		}
-
+	if titleRegion, contentRegion := section.SubRegions(); titleRegion != nil {
 		tsr.DrawTextRegion(titleRegion, screenTransform, region.Parent.Scale)
 
-		// Content region below title (lower Y in 3D space)
-		contentRegion := &core.TextRegion{
-			X:           region.X,
-			Y:           region.Y,
-			Width:       region.Width,
-			Height:      region.Height - titleHeight - titleGap,
-			Text:        section.Content,
-			Font:        contentFont,
-			Color:       section.Style.Color,
-			Scale:       section.Style.Scale,
-			LineSpacing: section.Style.LineSpacing,
-			CharSpacing: section.Style.CharSpacing,
-			HAlign:      section.Style.HAlign,
-			VAlign:      section.Style.VAlign,
-			WordWrap:    true,
-			Parent:      region.Parent,
+		if section.IsList() {
+			tsr.drawList(section, titleRegion.Y-section.TitleGap(), screenTransform)
+			return
 		}
 
 		tsr.DrawTextRegion(contentRegion, screenTransform, region.Parent.Scale)
+	} else if section.IsList() {
+		tsr.drawList(section, region.Y+region.Height, screenTransform)
 	} else {
 		region.Text = section.Content
-		region.Font = contentFont
+		region.Font = section.GetContentFont()
 		region.Color = section.Style.Color
 	}
 }
+
+// drawList draws a list section's items downward from top, each marker in
+// the column before its item's wrapped text.
+func (tsr *TextScreenRenderer) drawList(section *core.TextSection, top float32, transform rl.Matrix) {
+	items, markers := section.ListRegions(top)
+	for i, item := range items {
+		tsr.DrawTextRegion(item, transform, section.Region.Parent.Scale)
+
+		if markers[i].Text == core.ListBullet {
+			tsr.drawBullet(markers[i], transform)
+		} else {
+			tsr.DrawTextRegion(markers[i], transform, section.Region.Parent.Scale)
+		}
+	}
+}
+
+// drawBullet draws a dot in place of the bullet glyph, which Hershey fonts
+// lack, centered on where an "o" would sit on the region's first line.
+func (tsr *TextScreenRenderer) drawBullet(region *core.TextRegion, transform rl.Matrix) {
+	scale := region.Scale * region.Parent.Scale
+	baseline := region.CalculateStartY(float32(region.Font.Height) * scale)
+	dotWidth := region.CalculateLineWidth("o", scale)
+
+	center := rl.Vector3{
+		X: region.CalculateLineX(dotWidth) - dotWidth/2,
+		Y: baseline + float32(region.Font.Height)*scale*0.25,
+		Z: region.Parent.TextZOffset,
+	}
+	radius := float32(region.Font.Height) * scale * 0.1
+
+	rl.DrawSphere(rl.Vector3Transform(center, transform), radius, coreToRlColor(region.Color))
+}
@@ -0,0 +1,67 @@
+package raylib
+
+import (
+	"testing"
+
+	"github.com/chazu/spectrex/core"
+)
+
+func TestTextScreenRenderer_TransformMatchesCore(t *testing.T) {
+	rotations := []core.Vec3{
+		{},
+		{Y: 90},
+		{X: 30},
+		{Z: -45},
+		{X: -20, Y: 130, Z: 75},
+	}
+	points := []core.Vec3{{}, {X: 10}, {Y: 5}, {X: 3, Y: -2, Z: 1}}
+
+	tsr := NewTextScreenRenderer()
+	for _, rotation := range rotations {
+		screen := core.NewTextScreen(core.Vec3{X: 5, Y: -2, Z: 8}, 100, 40, 1.0)
+		screen.Rotation = rotation
+
+		got := tsr.calculateTransform(screen)
+		want := screen.GetTransformMatrix()
+		for _, p := range points {
+			if g, w := Vec3Transform(p, got), want.TransformVec3(p); !vec3Near(g, w) {
+				t.Errorf("rotation %v: %v maps to %v, core gives %v", rotation, p, g, w)
+			}
+		}
+	}
+
+	// Billboarded screens turn toward the camera the same way
+	screen := core.NewTextScreen(core.Vec3{X: 5}, 100, 40, 1.0)
+	screen.Billboard = core.BillboardSpherical
+	tsr.SetCamera(core.Camera{Position: core.Vec3{X: -20, Y: 10, Z: 30}})
+	got := tsr.calculateTransform(screen)
+	want := screen.GetViewTransformMatrix(core.Vec3{X: -20, Y: 10, Z: 30})
+	for _, p := range points {
+		if g, w := Vec3Transform(p, got), want.TransformVec3(p); !vec3Near(g, w) {
+			t.Errorf("billboard: %v maps to %v, core gives %v", p, g, w)
+		}
+	}
+}
+
+// vec3Near reports whether two vectors match to within float error.
+func vec3Near(a, b core.Vec3) bool {
+	return a.Sub(b).Length() < 1e-3
+}
+
+func TestTextScreenRenderer_ViewFallsBackToRendererCamera(t *testing.T) {
+	saved := viewCamera
+	defer func() { viewCamera = saved }()
+	viewCamera = core.Camera{Position: core.Vec3{X: 5, Y: 10, Z: -50}, Up: core.Vec3{Y: 1}, Fovy: 45}
+
+	// Without SetCamera, fills are wound toward the Begin3D camera
+	tsr := NewTextScreenRenderer()
+	if got := tsr.view(); got != viewCamera {
+		t.Errorf("view() before SetCamera = %+v, want the renderer's %+v", got, viewCamera)
+	}
+
+	camera := core.Camera{Position: core.Vec3{Z: 80}, Up: core.Vec3{Y: 1}, Fovy: 60}
+	tsr.SetCamera(camera)
+	if got := tsr.view(); got != camera {
+		t.Errorf("view() after SetCamera = %+v, want %+v", got, camera)
+	}
+}
@@ -0,0 +1,89 @@
+// Package svg provides font rendering for the Spectrex framework's SVG backend.
+package svg
+
+import (
+	"github.com/chazu/spectrex/core"
+)
+
+// FontRenderer implements core.FontRenderer by recording glyph strokes on
+// an SVG Renderer. Each glyph is written as one path.
+type FontRenderer struct {
+	renderer *Renderer
+
+	// LineThickness is the world-space width of glyph strokes.
+	// 0 draws them the renderer's StrokeWidth wide.
+	LineThickness float32
+
+	// Weight fakes bold text by drawing each stroke offset this many font
+	// units to either side as well; see core.GlyphStrokes. 0 draws plain
+	// strokes.
+	Weight float32
+}
+
+// NewFontRenderer creates a font renderer that draws onto renderer.
+func NewFontRenderer(renderer *Renderer) *FontRenderer {
+	return &FontRenderer{renderer: renderer}
+}
+
+// DrawGlyph draws a single glyph at the specified position.
+func (fr *FontRenderer) DrawGlyph(font *core.HersheyFont, char int, position core.Vec3, color core.Color, scale float32) {
+	fr.DrawGlyphTransformed(font, char, position, color, scale, core.MatrixIdentity())
+}
+
+// DrawText draws a complete text string centered at the position.
+func (fr *FontRenderer) DrawText(font *core.HersheyFont, text string, position core.Vec3, color core.Color, scale float32) {
+	offsets, totalWidth := font.GlyphAdvances(text, scale)
+	startX := totalWidth / 2.0
+
+	for i, char := range []rune(text) {
+		if char < 32 || char > 126 {
+			continue
+		}
+
+		glyphPos := core.Vec3{
+			X: position.X + startX - offsets[i],
+			Y: position.Y,
+			Z: position.Z,
+		}
+		fr.DrawGlyph(font, int(char), glyphPos, color, scale)
+	}
+}
+
+// DrawGlyphTransformed draws a glyph with a transformation matrix applied.
+func (fr *FontRenderer) DrawGlyphTransformed(font *core.HersheyFont, char int, position core.Vec3, color core.Color, scale float32, transform core.Matrix) {
+	if strokes := glyphStrokes(font, char, position, scale, fr.Weight, transform); len(strokes) > 0 {
+		fr.renderer.drawStrokes(strokes, fr.LineThickness, color)
+	}
+}
+
+// glyphStrokes returns the world-space strokes of a glyph drawn at
+// position with the given stroke weight, mirrored in X like the raylib
+// backend's 3D glyphs, then transformed. Returns nil for a missing or empty
+// glyph.
+func glyphStrokes(font *core.HersheyFont, char int, position core.Vec3, scale, weight float32, transform core.Matrix) [][2]core.Vec3 {
+	glyph, exists := font.Glyph(rune(char))
+	if !exists || len(glyph.Strokes) == 0 {
+		return nil
+	}
+	return strokeSegments(core.GlyphStrokes(glyph, weight), position, scale, transform)
+}
+
+// strokeSegments returns the world-space segments of glyph strokes drawn at
+// position, mirrored in X like glyphStrokes, then transformed.
+func strokeSegments(glyphStrokes []core.Stroke, position core.Vec3, scale float32, transform core.Matrix) [][2]core.Vec3 {
+	strokes := make([][2]core.Vec3, len(glyphStrokes))
+	for i, stroke := range glyphStrokes {
+		start := core.Vec3{
+			X: position.X - stroke.From.X*scale,
+			Y: position.Y + stroke.From.Y*scale,
+			Z: position.Z,
+		}
+		end := core.Vec3{
+			X: position.X - stroke.To.X*scale,
+			Y: position.Y + stroke.To.Y*scale,
+			Z: position.Z,
+		}
+		strokes[i] = [2]core.Vec3{transform.TransformVec3(start), transform.TransformVec3(end)}
+	}
+	return strokes
+}
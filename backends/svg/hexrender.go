@@ -0,0 +1,279 @@
+// Package svg provides hex grid rendering for the SVG backend.
+package svg
+
+import (
+	"github.com/chazu/spectrex/core"
+)
+
+// HexRenderer records hex grids on an SVG Renderer. Each cell is written
+// as a single hexagon, and each grid is drawn as one depth group so edges
+// always sit over the cell fills.
+type HexRenderer struct {
+	Config core.HexRenderConfig
+
+	// ColorResolver, if set, colors each edge as the blend of its two
+	// adjacent cells' colors. Edges styled by SetEdgeStyle or SetEdgeStyleFunc
+	// keep their color.
+	ColorResolver core.HexColorResolver
+
+	renderer *Renderer
+
+	// Style overrides by coordinate
+	cellStyles map[core.HexCoord]core.HexCellStyle
+	edgeStyles map[core.HexEdge]core.HexEdgeStyle
+
+	// Computed edge styles, consulted for edges without an override
+	edgeStyleFn func(core.HexEdge) (core.HexEdgeStyle, bool)
+}
+
+// NewHexRenderer creates a hex renderer with the given configuration that
+// draws onto renderer.
+func NewHexRenderer(renderer *Renderer, config core.HexRenderConfig) *HexRenderer {
+	return &HexRenderer{
+		Config:     config,
+		renderer:   renderer,
+		cellStyles: make(map[core.HexCoord]core.HexCellStyle),
+		edgeStyles: make(map[core.HexEdge]core.HexEdgeStyle),
+	}
+}
+
+// SetCellStyle sets a custom style for a specific cell.
+func (r *HexRenderer) SetCellStyle(coord core.HexCoord, style core.HexCellStyle) {
+	r.cellStyles[coord] = style
+}
+
+// ClearCellStyle removes the custom style for a cell, reverting to default.
+func (r *HexRenderer) ClearCellStyle(coord core.HexCoord) {
+	delete(r.cellStyles, coord)
+}
+
+// SetEdgeStyle sets a custom style for a specific edge.
+func (r *HexRenderer) SetEdgeStyle(edge core.HexEdge, style core.HexEdgeStyle) {
+	r.edgeStyles[edge] = style
+}
+
+// ClearEdgeStyle removes the custom style for an edge, reverting to default.
+func (r *HexRenderer) ClearEdgeStyle(edge core.HexEdge) {
+	delete(r.edgeStyles, edge)
+}
+
+// SetEdgeStyleFunc sets a function that styles edges across the whole grid,
+// such as every boundary edge. For each edge without a SetEdgeStyle
+// override, fn returns the style to draw and true, or false to fall back to
+// the default. Pass nil to remove it.
+func (r *HexRenderer) SetEdgeStyleFunc(fn func(edge core.HexEdge) (core.HexEdgeStyle, bool)) {
+	r.edgeStyleFn = fn
+}
+
+// ClearAllStyles removes all custom styles, including the SetEdgeStyleFunc
+// function.
+func (r *HexRenderer) ClearAllStyles() {
+	r.cellStyles = make(map[core.HexCoord]core.HexCellStyle)
+	r.edgeStyles = make(map[core.HexEdge]core.HexEdgeStyle)
+	r.edgeStyleFn = nil
+}
+
+// getCellStyle returns the style for a cell, using override if set.
+func (r *HexRenderer) getCellStyle(coord core.HexCoord) core.HexCellStyle {
+	if style, ok := r.cellStyles[coord]; ok {
+		return style
+	}
+	return r.Config.DefaultCell
+}
+
+// resolveEdgeStyle returns the style an edge is drawn with: its override if
+// set, then the SetEdgeStyleFunc style, otherwise the default with the
+// blended color from ColorResolver.
+func (r *HexRenderer) resolveEdgeStyle(edge core.HexEdge, inGrid func(core.HexCoord) bool) core.HexEdgeStyle {
+	style, overridden := r.edgeStyles[edge]
+	if !overridden && r.edgeStyleFn != nil {
+		style, overridden = r.edgeStyleFn(edge)
+	}
+	if !overridden {
+		style = r.Config.DefaultEdge
+		if r.ColorResolver != nil {
+			style.Color = core.BlendedEdgeColor(edge, inGrid, r.ColorResolver)
+		}
+	}
+	return style
+}
+
+// DrawGrid renders the entire hex grid.
+func (r *HexRenderer) DrawGrid(data core.HexGridRenderData) {
+	r.renderer.BeginGroup()
+	defer r.renderer.EndGroup()
+
+	// Walls go under the cells, and cells under the edges
+	if r.Config.DrawCells && r.Config.DrawWalls {
+		r.drawWalls(data.Walls, func(coord core.HexCoord) core.Color {
+			return r.getCellStyle(coord).FillColor
+		})
+	}
+
+	if r.Config.DrawCells {
+		for i, coord := range data.Cells {
+			style := r.getCellStyle(coord)
+			if style.FillColor.A > 0 {
+				r.renderer.fillPolygon3D(data.Vertices[i][:], style.FillColor)
+			}
+		}
+	}
+
+	if r.Config.DrawEdges {
+		r.drawEdges(data.AllEdges, data)
+	}
+}
+
+// DrawGridBoundaryOnly renders only the boundary edges of the grid.
+func (r *HexRenderer) DrawGridBoundaryOnly(data core.HexGridRenderData) {
+	r.renderer.BeginGroup()
+	defer r.renderer.EndGroup()
+
+	r.drawEdges(data.BoundaryEdges, data)
+}
+
+// DrawCell renders a single hex cell at the given coordinate.
+func (r *HexRenderer) DrawCell(coord core.HexCoord, style core.HexCellStyle) {
+	if style.FillColor.A == 0 {
+		return
+	}
+	vertices := r.Config.CellVertices3D(coord)
+	r.renderer.fillPolygon3D(vertices[:], style.FillColor)
+}
+
+// DrawCellEdges renders all edges of a single cell.
+func (r *HexRenderer) DrawCellEdges(coord core.HexCoord, style core.HexEdgeStyle) {
+	r.renderer.BeginGroup()
+	defer r.renderer.EndGroup()
+
+	vertices := r.Config.CellVertices3D(coord)
+	for dir := core.HexDirE; dir <= core.HexDirSE; dir++ {
+		v1, v2 := core.HexEdgeVertices3D(vertices, dir, r.Config.Layout.Orientation)
+		r.drawEdgeLine(v1, v2, style)
+	}
+}
+
+// DrawCellLabel draws text centered in a cell, lying flat on the grid plane
+// and scaled to fit inside the hex, matching the raylib backend.
+func (r *HexRenderer) DrawCellLabel(coord core.HexCoord, text string, font *core.HersheyFont, color core.Color) {
+	scale := core.HexLabelScale(font, text, r.Config.CellRadius())
+	if scale <= 0 {
+		return
+	}
+
+	center := core.HexCenter3D(r.Config.Layout, coord)
+	center.Y = r.Config.Elevation(coord)
+	offsets, width := font.GlyphAdvances(text, scale)
+
+	// Glyphs advance toward -X, with glyph Y along +Z. The baseline sits
+	// below center by about half the cap height.
+	startX := center.X + width/2
+	baseline := center.Z - float32(font.Height)*scale*0.35
+
+	for i, char := range []rune(text) {
+		if char < 32 || char > 126 {
+			continue
+		}
+		glyph, exists := font.Glyph(char)
+		if !exists || len(glyph.Strokes) == 0 {
+			continue
+		}
+
+		originX := startX - offsets[i]
+		strokes := make([][2]core.Vec3, len(glyph.Strokes))
+		for j, stroke := range glyph.Strokes {
+			strokes[j] = [2]core.Vec3{
+				{X: originX - stroke.From.X*scale, Y: center.Y, Z: baseline + stroke.From.Y*scale},
+				{X: originX - stroke.To.X*scale, Y: center.Y, Z: baseline + stroke.To.Y*scale},
+			}
+		}
+		r.renderer.drawStrokes(strokes, 0, color)
+	}
+}
+
+// DrawGridWithCallback renders the grid, calling the callback for each cell
+// to get customized styles. This is useful for dynamic styling.
+func (r *HexRenderer) DrawGridWithCallback(
+	data core.HexGridRenderData,
+	cellStyleFn func(coord core.HexCoord) *core.HexCellStyle,
+	edgeStyleFn func(edge core.HexEdge) *core.HexEdgeStyle,
+) {
+	r.renderer.BeginGroup()
+	defer r.renderer.EndGroup()
+
+	if r.Config.DrawCells && r.Config.DrawWalls && cellStyleFn != nil {
+		r.drawWalls(data.Walls, func(coord core.HexCoord) core.Color {
+			if style := cellStyleFn(coord); style != nil {
+				return style.FillColor
+			}
+			return core.Color{}
+		})
+	}
+
+	if r.Config.DrawCells && cellStyleFn != nil {
+		for i, coord := range data.Cells {
+			if style := cellStyleFn(coord); style != nil && style.FillColor.A > 0 {
+				r.renderer.fillPolygon3D(data.Vertices[i][:], style.FillColor)
+			}
+		}
+	}
+
+	if r.Config.DrawEdges && edgeStyleFn != nil {
+		for _, edge := range data.AllEdges {
+			if style := edgeStyleFn(edge); style != nil {
+				if idx, ok := data.IndexOf(edge.Coord); ok {
+					v1, v2 := core.HexEdgeVertices3D(data.Vertices[idx], edge.Dir, r.Config.Layout.Orientation)
+					r.drawEdgeLine(v1, v2, *style)
+				}
+			}
+		}
+	}
+}
+
+// drawWalls fills the walls of raised cells, shaded from the fill color
+// returned for their cell.
+func (r *HexRenderer) drawWalls(walls []core.HexWall, fill func(coord core.HexCoord) core.Color) {
+	for _, wall := range walls {
+		if color := fill(wall.Coord); color.A > 0 {
+			r.renderer.fillPolygon3D(wall.Vertices[:], core.HexWallColor(color))
+		}
+	}
+}
+
+// drawEdges renders a list of edges.
+func (r *HexRenderer) drawEdges(edges []core.HexEdge, data core.HexGridRenderData) {
+	inGrid := func(coord core.HexCoord) bool {
+		_, ok := data.IndexOf(coord)
+		return ok
+	}
+
+	for _, edge := range edges {
+		style := r.resolveEdgeStyle(edge, inGrid)
+
+		var vertices [6]core.Vec3
+		if idx, ok := data.IndexOf(edge.Coord); ok {
+			vertices = data.Vertices[idx]
+		} else {
+			// Compute vertices on the fly if not in pre-computed data
+			vertices = r.Config.CellVertices3D(edge.Coord)
+		}
+
+		v1, v2 := core.HexEdgeVertices3D(vertices, edge.Dir, r.Config.Layout.Orientation)
+		r.drawEdgeLine(v1, v2, style)
+	}
+}
+
+// drawEdgeLine renders a single edge line with the given style. Dashed
+// edges are written as one path of dashes.
+func (r *HexRenderer) drawEdgeLine(v1, v2 core.Vec3, style core.HexEdgeStyle) {
+	if !style.Dashed {
+		r.renderer.drawLine3D(v1, v2, style.Thickness, style.Color)
+		return
+	}
+
+	dashes := core.DashSegments(v1, v2, r.Config.DashLength, r.Config.DashGap, style.DashOffset)
+	if len(dashes) == 0 {
+		return
+	}
+	r.renderer.drawStrokes(dashes, style.Thickness, style.Color)
+}
@@ -0,0 +1,41 @@
+package svg
+
+import (
+	"testing"
+
+	"github.com/chazu/spectrex/core"
+)
+
+func TestHexRenderer_EdgeStyleFunc(t *testing.T) {
+	config := core.DefaultHexRenderConfig(10)
+	hexes := NewHexRenderer(NewRenderer(100, 100), config)
+	grid := core.NewHexGrid[int](1)
+	inGrid := grid.IsValid
+
+	boundary := core.HexEdgeStyle{Color: core.ColorRed, Thickness: 2}
+	hexes.SetEdgeStyleFunc(func(edge core.HexEdge) (core.HexEdgeStyle, bool) {
+		// Boundary edges have a cell outside the grid on one side
+		return boundary, !inGrid(edge.Coord) || !inGrid(edge.Coord.Neighbor(edge.Dir))
+	})
+
+	interior := core.HexEdge{Coord: core.HexCoord{Q: 0, R: 0}, Dir: core.HexDirE}
+	outer := core.HexEdge{Coord: core.HexCoord{Q: 1, R: 0}, Dir: core.HexDirE}
+	override := core.HexEdge{Coord: core.HexCoord{Q: 1, R: -1}, Dir: core.HexDirNE}
+	hexes.SetEdgeStyle(override, core.HexEdgeStyle{Color: core.ColorBlue})
+
+	if got := hexes.resolveEdgeStyle(interior, inGrid); got != config.DefaultEdge {
+		t.Errorf("interior edge style = %+v, want default %+v", got, config.DefaultEdge)
+	}
+	if got := hexes.resolveEdgeStyle(outer, inGrid); got != boundary {
+		t.Errorf("boundary edge style = %+v, want %+v", got, boundary)
+	}
+	// Per-edge overrides take precedence over the function
+	if got := hexes.resolveEdgeStyle(override, inGrid); got.Color != core.ColorBlue {
+		t.Errorf("overridden edge color = %v, want blue", got.Color)
+	}
+
+	hexes.ClearAllStyles()
+	if got := hexes.resolveEdgeStyle(outer, inGrid); got != config.DefaultEdge {
+		t.Errorf("after ClearAllStyles boundary edge style = %+v, want default", got)
+	}
+}
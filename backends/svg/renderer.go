@@ -0,0 +1,432 @@
+// Package svg provides an SVG export renderer for the Spectrex framework.
+// Primitives are projected through the camera and recorded, then written
+// as a single <svg> document with WriteTo.
+package svg
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/chazu/spectrex/core"
+)
+
+// shapeKind identifies how a recorded shape is written.
+type shapeKind int
+
+const (
+	shapeLine    shapeKind = iota // Two points, stroked
+	shapePolygon                  // Closed outline, filled
+	shapePath                     // Point pairs, one stroked segment each
+	shapeText                     // Screen-space label
+)
+
+// shape is a primitive already projected to pixel coordinates.
+type shape struct {
+	kind   shapeKind
+	points []core.Vec2
+	color  core.Color
+	width  float32 // Stroke width in pixels
+	depth  float32 // Mean view depth, used to order 3D shapes
+	text   string
+	size   float32 // Font size in pixels
+}
+
+// Renderer implements core.Renderer by recording primitives for SVG output.
+// SVG has no depth buffer, so 3D shapes are written farthest first.
+type Renderer struct {
+	Width  int32
+	Height int32
+
+	// Background fills the document behind the scene.
+	// Alpha 0 leaves it transparent.
+	Background core.Color
+
+	// StrokeWidth is the pixel width of lines with no world-space thickness.
+	StrokeWidth float32
+
+	camera  core.Camera
+	shapes  []shape // 3D shapes, depth sorted on write
+	overlay []shape // 2D shapes, written on top in draw order
+
+	groupLevel int // Nesting depth of BeginGroup calls
+	groupStart int // Index in shapes of the outermost open group's first shape
+}
+
+// NewRenderer creates a new SVG renderer producing a width x height image.
+func NewRenderer(width, height int32) *Renderer {
+	return &Renderer{
+		Width:       width,
+		Height:      height,
+		Background:  core.ColorBlack,
+		StrokeWidth: 1,
+		camera:      core.NewDefaultCamera(),
+	}
+}
+
+// BeginFrame begins a new frame, discarding everything recorded so far.
+func (r *Renderer) BeginFrame() {
+	r.shapes = r.shapes[:0]
+	r.overlay = r.overlay[:0]
+	r.groupLevel = 0
+}
+
+// EndFrame ends the current frame. The recorded frame is kept for WriteTo.
+func (r *Renderer) EndFrame() {}
+
+// Begin3D sets the camera used to project the 3D primitives that follow.
+func (r *Renderer) Begin3D(camera core.Camera) {
+	r.camera = camera
+}
+
+// End3D ends 3D rendering.
+func (r *Renderer) End3D() {}
+
+// BeginGroup starts a group of 3D shapes that are depth sorted as one, at
+// their mean depth, and keep their draw order within the group. Use it for
+// coplanar layers such as text over a background, which would otherwise
+// interleave. Groups may nest; only the outermost group counts.
+func (r *Renderer) BeginGroup() {
+	if r.groupLevel == 0 {
+		r.groupStart = len(r.shapes)
+	}
+	r.groupLevel++
+}
+
+// EndGroup ends the group started by the matching BeginGroup.
+func (r *Renderer) EndGroup() {
+	if r.groupLevel == 0 {
+		return
+	}
+	r.groupLevel--
+	if r.groupLevel > 0 {
+		return
+	}
+
+	group := r.shapes[r.groupStart:]
+	if len(group) == 0 {
+		return
+	}
+	var depth float32
+	for _, s := range group {
+		depth += s.depth
+	}
+	depth /= float32(len(group))
+	for i := range group {
+		group[i].depth = depth
+	}
+}
+
+// Camera returns the camera 3D primitives are projected with.
+func (r *Renderer) Camera() core.Camera {
+	return r.camera
+}
+
+// DrawLine3D draws a 3D line StrokeWidth pixels wide.
+func (r *Renderer) DrawLine3D(start, end core.Vec3, color core.Color) {
+	r.drawLine3D(start, end, 0, color)
+}
+
+// DrawTriangle3D draws a filled 3D triangle.
+func (r *Renderer) DrawTriangle3D(v1, v2, v3 core.Vec3, color core.Color) {
+	r.fillPolygon3D([]core.Vec3{v1, v2, v3}, color)
+}
+
+// DrawPolygon3D fills a convex polygon, written as a single polygon
+// element. Polygons with fewer than 3 vertices draw nothing.
+func (r *Renderer) DrawPolygon3D(vertices []core.Vec3, color core.Color) {
+	if len(vertices) < 3 {
+		return
+	}
+	r.fillPolygon3D(vertices, color)
+}
+
+// DrawPolygonLines3D draws a polygon's closed outline as one path.
+func (r *Renderer) DrawPolygonLines3D(vertices []core.Vec3, color core.Color) {
+	edges := core.PolygonEdges(len(vertices))
+	strokes := make([][2]core.Vec3, len(edges))
+	for i, edge := range edges {
+		strokes[i] = [2]core.Vec3{vertices[edge[0]], vertices[edge[1]]}
+	}
+	r.drawStrokes(strokes, 0, color)
+}
+
+// DrawCircle3D draws a circle outline as a loop of segments lines in the
+// plane facing normal. A zero normal draws on the XZ plane.
+func (r *Renderer) DrawCircle3D(center core.Vec3, radius float32, normal core.Vec3, segments int, color core.Color) {
+	points := core.CirclePoints(center, radius, normal, segments)
+	strokes := make([][2]core.Vec3, len(points))
+	for i := range points {
+		strokes[i] = [2]core.Vec3{points[i], points[(i+1)%len(points)]}
+	}
+	r.drawStrokes(strokes, 0, color)
+}
+
+// DrawArc3D draws an arc from startAngle to endAngle (degrees) as segments
+// lines in the plane facing normal. A zero normal draws on the XZ plane,
+// with angle 0 along +X.
+func (r *Renderer) DrawArc3D(center core.Vec3, radius, startAngle, endAngle float32, normal core.Vec3, segments int, color core.Color) {
+	points := core.ArcPoints(center, radius, startAngle, endAngle, normal, segments)
+	strokes := make([][2]core.Vec3, 0, len(points))
+	for i := 1; i < len(points); i++ {
+		strokes = append(strokes, [2]core.Vec3{points[i-1], points[i]})
+	}
+	r.drawStrokes(strokes, 0, color)
+}
+
+// DrawGrid draws a reference grid on the XZ plane centered on the origin,
+// matching raylib's DrawGrid.
+func (r *Renderer) DrawGrid(slices int, spacing float32) {
+	r.BeginGroup()
+	defer r.EndGroup()
+
+	half := slices / 2
+	extent := float32(half) * spacing
+
+	for i := -half; i <= half; i++ {
+		color := core.Color{R: 191, G: 191, B: 191, A: 255}
+		if i == 0 {
+			color = core.Color{R: 127, G: 127, B: 127, A: 255}
+		}
+
+		offset := float32(i) * spacing
+		r.DrawLine3D(core.Vec3{X: offset, Z: -extent}, core.Vec3{X: offset, Z: extent}, color)
+		r.DrawLine3D(core.Vec3{X: -extent, Z: offset}, core.Vec3{X: extent, Z: offset}, color)
+	}
+}
+
+// DrawFPS does nothing; an exported image has no frame rate.
+func (r *Renderer) DrawFPS(x, y int32) {}
+
+// DrawText2D draws 2D text on screen as an SVG text element with its
+// top-left corner at (x, y).
+func (r *Renderer) DrawText2D(text string, x, y int32, fontSize int32, color core.Color) {
+	// SVG positions text by its baseline; place it one ascent down
+	r.overlay = append(r.overlay, shape{
+		kind:   shapeText,
+		points: []core.Vec2{{X: float32(x), Y: float32(y) + float32(fontSize)*0.8}},
+		color:  color,
+		text:   text,
+		size:   float32(fontSize),
+	})
+}
+
+// DrawLine2D draws a screen-space line StrokeWidth pixels wide over the scene.
+func (r *Renderer) DrawLine2D(start, end core.Vec2, color core.Color) {
+	r.overlay = append(r.overlay, shape{
+		kind:   shapeLine,
+		points: []core.Vec2{start, end},
+		color:  color,
+		width:  r.StrokeWidth,
+	})
+}
+
+// GetScreenWidth returns the image width.
+func (r *Renderer) GetScreenWidth() int32 {
+	return r.Width
+}
+
+// GetScreenHeight returns the image height.
+func (r *Renderer) GetScreenHeight() int32 {
+	return r.Height
+}
+
+// aspect returns the image width divided by its height.
+func (r *Renderer) aspect() float32 {
+	if r.Height == 0 {
+		return 1
+	}
+	return float32(r.Width) / float32(r.Height)
+}
+
+// project maps a world point, already clipped to the near plane, to pixel
+// coordinates.
+func (r *Renderer) project(point core.Vec3) (core.Vec2, float32) {
+	ndc, depth, _ := r.camera.Project(point, r.aspect())
+	return core.Vec2{
+		X: (ndc.X + 1) / 2 * float32(r.Width),
+		Y: (1 - ndc.Y) / 2 * float32(r.Height),
+	}, depth
+}
+
+// pixelWidth converts a world-space thickness at the given depth to pixels.
+// A thickness of 0 gives StrokeWidth.
+func (r *Renderer) pixelWidth(thickness, depth float32) float32 {
+	if thickness <= 0 {
+		return r.StrokeWidth
+	}
+	width := r.camera.PixelSize(thickness, depth, float32(r.Height))
+	if width <= 0 {
+		return r.StrokeWidth
+	}
+	return width
+}
+
+// drawLine3D records a line thickness world units wide; 0 draws it
+// StrokeWidth pixels wide.
+func (r *Renderer) drawLine3D(start, end core.Vec3, thickness float32, color core.Color) {
+	start, end, ok := r.camera.ClipSegment(start, end)
+	if !ok {
+		return
+	}
+
+	a, da := r.project(start)
+	b, db := r.project(end)
+	depth := (da + db) / 2
+	r.shapes = append(r.shapes, shape{
+		kind:   shapeLine,
+		points: []core.Vec2{a, b},
+		color:  color,
+		width:  r.pixelWidth(thickness, depth),
+		depth:  depth,
+	})
+}
+
+// drawStrokes records a set of segments as one path, such as the strokes
+// of a glyph, so they are written as a single element.
+func (r *Renderer) drawStrokes(strokes [][2]core.Vec3, thickness float32, color core.Color) {
+	var points []core.Vec2
+	var depth float32
+	for _, stroke := range strokes {
+		start, end, ok := r.camera.ClipSegment(stroke[0], stroke[1])
+		if !ok {
+			continue
+		}
+		a, da := r.project(start)
+		b, db := r.project(end)
+		points = append(points, a, b)
+		depth += da + db
+	}
+	if len(points) == 0 {
+		return
+	}
+
+	depth /= float32(len(points))
+	r.shapes = append(r.shapes, shape{
+		kind:   shapePath,
+		points: points,
+		color:  color,
+		width:  r.pixelWidth(thickness, depth),
+		depth:  depth,
+	})
+}
+
+// fillPolygon3D records a filled polygon.
+func (r *Renderer) fillPolygon3D(vertices []core.Vec3, color core.Color) {
+	clipped := r.camera.ClipPolygon(vertices)
+	if clipped == nil {
+		return
+	}
+
+	points := make([]core.Vec2, len(clipped))
+	var depth float32
+	for i, v := range clipped {
+		var d float32
+		points[i], d = r.project(v)
+		depth += d
+	}
+
+	r.shapes = append(r.shapes, shape{
+		kind:   shapePolygon,
+		points: points,
+		color:  color,
+		depth:  depth / float32(len(points)),
+	})
+}
+
+// WriteTo writes the recorded frame to w as an SVG document.
+func (r *Renderer) WriteTo(w io.Writer) (int64, error) {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n",
+		r.Width, r.Height, r.Width, r.Height)
+	if r.Background.A > 0 {
+		fmt.Fprintf(&b, `<rect width="100%%" height="100%%"%s/>`+"\n", paint("fill", r.Background))
+	}
+
+	// Painter's algorithm: farthest first, draw order breaking ties
+	shapes := make([]shape, len(r.shapes))
+	copy(shapes, r.shapes)
+	sort.SliceStable(shapes, func(i, j int) bool {
+		return shapes[i].depth > shapes[j].depth
+	})
+
+	for _, s := range shapes {
+		writeShape(&b, s)
+	}
+	for _, s := range r.overlay {
+		writeShape(&b, s)
+	}
+	b.WriteString("</svg>\n")
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// writeShape writes one shape as an SVG element.
+func writeShape(b *strings.Builder, s shape) {
+	switch s.kind {
+	case shapeLine:
+		fmt.Fprintf(b, `<line x1="%s" y1="%s" x2="%s" y2="%s"%s stroke-width="%s" stroke-linecap="round"/>`+"\n",
+			num(s.points[0].X), num(s.points[0].Y), num(s.points[1].X), num(s.points[1].Y),
+			paint("stroke", s.color), num(s.width))
+
+	case shapePolygon:
+		coords := make([]string, len(s.points))
+		for i, p := range s.points {
+			coords[i] = num(p.X) + "," + num(p.Y)
+		}
+		fmt.Fprintf(b, `<polygon points="%s"%s/>`+"\n", strings.Join(coords, " "), paint("fill", s.color))
+
+	case shapePath:
+		fmt.Fprintf(b, `<path d="%s" fill="none"%s stroke-width="%s" stroke-linecap="round" stroke-linejoin="round"/>`+"\n",
+			pathData(s.points), paint("stroke", s.color), num(s.width))
+
+	case shapeText:
+		fmt.Fprintf(b, `<text x="%s" y="%s" font-family="monospace" font-size="%s"%s>%s</text>`+"\n",
+			num(s.points[0].X), num(s.points[0].Y), num(s.size), paint("fill", s.color), escape(s.text))
+	}
+}
+
+// pathData returns SVG path data for point pairs, continuing a subpath
+// when a segment starts where the previous one ended.
+func pathData(points []core.Vec2) string {
+	var d []string
+	for i := 0; i+1 < len(points); i += 2 {
+		start, end := points[i], points[i+1]
+		if i == 0 || start != points[i-1] {
+			d = append(d, "M"+num(start.X)+" "+num(start.Y))
+		}
+		d = append(d, "L"+num(end.X)+" "+num(end.Y))
+	}
+	return strings.Join(d, " ")
+}
+
+// paint returns the attribute setting a fill or stroke to color, with an
+// opacity attribute when the color is translucent.
+func paint(attr string, c core.Color) string {
+	s := fmt.Sprintf(` %s="rgb(%d,%d,%d)"`, attr, c.R, c.G, c.B)
+	if c.A < 255 {
+		s += fmt.Sprintf(` %s-opacity="%s"`, attr, num(float32(c.A)/255))
+	}
+	return s
+}
+
+// num formats a coordinate with at most two decimals, so output is stable
+// and compact.
+func num(v float32) string {
+	s := strconv.FormatFloat(float64(v), 'f', 2, 32)
+	s = strings.TrimRight(s, "0")
+	s = strings.TrimSuffix(s, ".")
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+// escape replaces the characters that are special in SVG text content.
+func escape(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
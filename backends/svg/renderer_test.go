@@ -0,0 +1,146 @@
+package svg
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/chazu/spectrex/core"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files in testdata")
+
+// The SVG renderers satisfy the core interfaces.
+var (
+	_ core.Renderer           = (*Renderer)(nil)
+	_ core.FontRenderer       = (*FontRenderer)(nil)
+	_ core.TextScreenRenderer = (*TextScreenRenderer)(nil)
+)
+
+// drawTestScene draws a small scene touching every renderer in the package.
+func drawTestScene(r *Renderer) {
+	camera := core.Camera{
+		Position: core.Vec3{X: 0, Y: 120, Z: -160},
+		Target:   core.Vec3{X: 0, Y: 0, Z: 0},
+		Up:       core.Vec3{X: 0, Y: 1, Z: 0},
+		Fovy:     45,
+	}
+
+	r.BeginFrame()
+	r.Begin3D(camera)
+
+	r.DrawGrid(4, 20)
+	r.DrawTriangle3D(core.Vec3{X: -40, Z: 30}, core.Vec3{X: -20, Z: 30}, core.Vec3{X: -30, Y: 20, Z: 30}, core.ColorOrange)
+	r.DrawCircle3D(core.Vec3{X: 40, Z: 30}, 10, core.Vec3{}, 8, core.ColorLime)
+
+	config := core.DefaultHexRenderConfig(10)
+	config.DefaultEdge.Color = core.ColorSkyBlue
+	hexes := NewHexRenderer(r, config)
+	hexes.SetEdgeStyle(core.HexEdge{Coord: core.HexCoord{Q: 0, R: 0}, Dir: core.HexDirE},
+		core.HexEdgeStyle{Color: core.ColorYellow, Dashed: true})
+	grid := core.NewHexGrid[int](1)
+	hexes.DrawGrid(core.PrepareGridRenderData(grid, config))
+
+	font := core.LoadHersheyFontData()
+	screen := core.NewTextScreen(core.Vec3{X: 50, Y: 45, Z: 40}, 100, 40, 1.0)
+	screen.SetTransparency(false)
+	screen.SetBackground(core.Color{R: 20, G: 20, B: 60, A: 200})
+	region := screen.AddRegion(0, 0, 100, 40)
+	region.SetContent("SVG", font, core.ColorWhite)
+	region.SetAlignment(core.AlignCenter, core.AlignMiddle)
+	region.Underline = true
+	NewTextScreenRenderer(r).DrawTextScreen(screen)
+
+	r.End3D()
+	r.DrawText2D("a < b", 4, 4, 10, core.ColorWhite)
+	r.EndFrame()
+}
+
+func TestRenderer_Golden(t *testing.T) {
+	r := NewRenderer(320, 200)
+	drawTestScene(r)
+
+	var buf bytes.Buffer
+	if _, err := r.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+
+	golden := filepath.Join("testdata", "scene.svg")
+	if *update {
+		if err := os.WriteFile(golden, buf.Bytes(), 0o644); err != nil {
+			t.Fatalf("writing golden file: %v", err)
+		}
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("reading golden file (run with -update to create it): %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("output differs from %s; run with -update to accept\ngot:\n%s", golden, buf.String())
+	}
+}
+
+func TestRenderer_DepthOrder(t *testing.T) {
+	r := NewRenderer(100, 100)
+	r.Background = core.Color{}
+	r.Begin3D(core.Camera{
+		Position: core.Vec3{X: 0, Y: 0, Z: -10},
+		Target:   core.Vec3{X: 0, Y: 0, Z: 0},
+		Up:       core.Vec3{X: 0, Y: 1, Z: 0},
+		Fovy:     90,
+	})
+
+	// Drawn near first, so sorting must move the far triangle ahead of it
+	r.DrawTriangle3D(core.Vec3{X: -1, Z: 0}, core.Vec3{X: 1, Z: 0}, core.Vec3{Y: 1, Z: 0}, core.ColorRed)
+	r.DrawTriangle3D(core.Vec3{X: -1, Z: 5}, core.Vec3{X: 1, Z: 5}, core.Vec3{Y: 1, Z: 5}, core.ColorBlue)
+	// Entirely behind the camera, so dropped
+	r.DrawLine3D(core.Vec3{Z: -20}, core.Vec3{X: 1, Z: -30}, core.ColorGreen)
+
+	var buf bytes.Buffer
+	if _, err := r.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+	out := buf.String()
+
+	blue := strings.Index(out, "rgb(0,0,255)")
+	red := strings.Index(out, "rgb(255,0,0)")
+	if blue < 0 || red < 0 || blue > red {
+		t.Errorf("far blue triangle should be written before near red one:\n%s", out)
+	}
+	if strings.Contains(out, "rgb(0,255,0)") {
+		t.Errorf("line behind the camera should be dropped:\n%s", out)
+	}
+	if strings.Contains(out, "<rect") {
+		t.Errorf("transparent background should not be written:\n%s", out)
+	}
+}
+
+func TestRenderer_Groups(t *testing.T) {
+	r := NewRenderer(100, 100)
+	r.Begin3D(core.Camera{
+		Position: core.Vec3{X: 0, Y: 0, Z: -10},
+		Target:   core.Vec3{X: 0, Y: 0, Z: 0},
+		Up:       core.Vec3{X: 0, Y: 1, Z: 0},
+		Fovy:     90,
+	})
+
+	// A tilted background with a line over its near half: alone, the line
+	// would sort in front, but over its far half it would sort behind
+	r.BeginGroup()
+	r.DrawTriangle3D(core.Vec3{X: -5, Z: -5}, core.Vec3{X: 5, Z: 5}, core.Vec3{Y: 5}, core.ColorBlue)
+	r.DrawLine3D(core.Vec3{X: 2, Z: 2}, core.Vec3{X: 4, Z: 4}, core.ColorRed)
+	r.EndGroup()
+
+	var buf bytes.Buffer
+	if _, err := r.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo: %v", err)
+	}
+	out := buf.String()
+	if strings.Index(out, "rgb(0,0,255)") > strings.Index(out, "rgb(255,0,0)") {
+		t.Errorf("grouped shapes should keep draw order:\n%s", out)
+	}
+}
@@ -0,0 +1,379 @@
+// Package svg provides text screen rendering for the Spectrex framework's SVG backend.
+package svg
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/chazu/spectrex/core"
+)
+
+// TextScreenRenderer implements core.TextScreenRenderer by recording text
+// screens on an SVG Renderer. Each screen is drawn as one depth group, so
+// its text always sits over its background. Billboarded screens face the
+// renderer's camera.
+type TextScreenRenderer struct {
+	renderer *Renderer
+
+	// LineThickness is the world-space width of glyph strokes.
+	// 0 draws them the renderer's StrokeWidth wide.
+	LineThickness float32
+
+	// runes is drawLine's scratch buffer, reused across lines and frames
+	runes []rune
+}
+
+// NewTextScreenRenderer creates a text screen renderer that draws onto renderer.
+func NewTextScreenRenderer(renderer *Renderer) *TextScreenRenderer {
+	return &TextScreenRenderer{renderer: renderer}
+}
+
+// DrawTextScreen renders a complete text screen with all its regions.
+func (tsr *TextScreenRenderer) DrawTextScreen(screen *core.TextScreen) {
+	tsr.renderer.BeginGroup()
+	defer tsr.renderer.EndGroup()
+
+	model := screen.GetViewTransformMatrix(tsr.renderer.Camera().Position)
+
+	if !screen.Transparent {
+		tsr.drawScreenBackground(screen, model)
+	}
+
+	if screen.ShowBorder || screen.Debug {
+		borderColor := screen.BorderColor
+		if screen.Debug {
+			borderColor = core.ColorBlue
+		}
+		tsr.drawRectOutline(0, 0, screen.Width, screen.Height, borderColor, offsetZ(model, screen.BorderZOffset))
+	}
+
+	for _, region := range screen.Regions {
+		tsr.DrawTextRegion(region, model, screen.Scale)
+	}
+}
+
+// DrawTextScreens renders several screens sorted by depth from the camera.
+func (tsr *TextScreenRenderer) DrawTextScreens(screens []*core.TextScreen, camera core.Camera) {
+	for _, screen := range core.SortScreensByDepth(screens, camera.Position) {
+		tsr.DrawTextScreen(screen)
+	}
+}
+
+// DrawTextRegion renders a single text region.
+func (tsr *TextScreenRenderer) DrawTextRegion(region *core.TextRegion, screenTransform core.Matrix, screenScale float32) {
+	if !region.Transparent {
+		tsr.drawRect(region.X, region.Y, region.Width, region.Height, region.BackgroundColor, screenTransform)
+	}
+
+	if region.ShowBorder || region.Parent.Debug {
+		borderColor := region.BorderColor
+		if region.Parent.Debug {
+			borderColor = core.ColorRed
+		}
+		tsr.drawRectOutline(region.X, region.Y, region.Width, region.Height, borderColor, offsetZ(screenTransform, region.Parent.BorderZOffset))
+	}
+
+	if region.Font == nil || region.Text == "" {
+		return
+	}
+
+	effectiveScale := region.Scale * screenScale
+	lines, offsets := region.GetLinesWithOffsets()
+	if len(lines) == 0 {
+		return
+	}
+
+	totalTextHeight := region.CalculateTextHeight(lines)
+	// Scrolling moves the text up (+Y) so later lines enter the region
+	startY := region.CalculateStartY(totalTextHeight) + region.ScrollOffset
+	lineHeight := float32(region.Font.Height) * effectiveScale
+	innerX, _, innerWidth, _ := region.InnerRect()
+	// Text sits just in front of the backgrounds so it does not z-fight
+	textTransform := offsetZ(screenTransform, region.Parent.TextZOffset)
+	boxes := region.UseTextBoxesFrom(tsr.renderer.Camera(), textTransform, float32(tsr.renderer.Height))
+
+	for i, line := range lines {
+		// Subtract Y because in 3D space Y increases upward, but text flows downward
+		yPos := startY - float32(i)*lineHeight*region.LineSpacing
+
+		if !region.LineVisible(yPos) {
+			continue
+		}
+
+		if region.TruncateOverflow && region.HAlign != core.AlignJustified {
+			lineWidth := region.CalculateLineWidth(line, effectiveScale)
+			if lineWidth > innerWidth && !strings.HasSuffix(line, region.OverflowMarker) {
+				markerWidth := region.CalculateLineWidth(region.OverflowMarker, effectiveScale)
+				line = region.TruncateLineToFit(line, innerWidth-markerWidth, effectiveScale) + region.OverflowMarker
+			}
+		}
+
+		if boxes {
+			tsr.drawLineBox(region, line, yPos, region.IsJustified(line, i, len(lines)), textTransform)
+			continue
+		}
+
+		if region.IsJustified(line, i, len(lines)) {
+			tsr.drawJustifiedLine(region, line, offsets[i], innerX+innerWidth, yPos, effectiveScale, textTransform)
+			tsr.drawDecorations(region, line, yPos, true, textTransform)
+			continue
+		}
+
+		lineWidth := region.CalculateLineWidth(line, effectiveScale)
+		xPos := region.CalculateLineX(lineWidth)
+
+		tsr.drawLine(region, line, offsets[i], core.Vec3{X: xPos, Y: yPos}, effectiveScale, textTransform)
+		tsr.drawDecorations(region, line, yPos, false, textTransform)
+	}
+}
+
+// DrawTextDocument renders a complete text document.
+func (tsr *TextScreenRenderer) DrawTextDocument(doc *core.TextDocument) {
+	if len(doc.Sections) > 0 && doc.Sections[0].Region == nil {
+		doc.Layout()
+	}
+
+	tsr.renderer.BeginGroup()
+	defer tsr.renderer.EndGroup()
+
+	for _, section := range doc.Sections {
+		tsr.drawSection(section)
+	}
+}
+
+// drawScreenBackground draws the screen's background in its BackgroundMode.
+func (tsr *TextScreenRenderer) drawScreenBackground(screen *core.TextScreen, transform core.Matrix) {
+	switch screen.BackgroundMode {
+	case core.BackgroundWireframe:
+		// The outline plus the diagonal shared by the two fill triangles
+		corners := rectCorners(0, 0, screen.Width, screen.Height, transform)
+		strokes := [][2]core.Vec3{{corners[0], corners[2]}}
+		for i := range corners {
+			strokes = append(strokes, [2]core.Vec3{corners[i], corners[(i+1)%len(corners)]})
+		}
+		tsr.renderer.drawStrokes(strokes, 0, screen.BackgroundColor)
+	case core.BackgroundScanlines:
+		var strokes [][2]core.Vec3
+		for _, y := range screen.Scanlines() {
+			strokes = append(strokes, [2]core.Vec3{
+				transform.TransformVec3(core.Vec3{Y: y}),
+				transform.TransformVec3(core.Vec3{X: screen.Width, Y: y}),
+			})
+		}
+		if len(strokes) > 0 {
+			tsr.renderer.drawStrokes(strokes, 0, screen.BackgroundColor)
+		}
+	default:
+		tsr.drawRect(0, 0, screen.Width, screen.Height, screen.BackgroundColor, transform)
+	}
+}
+
+// offsetZ returns transform applied after moving z along the local Z
+// axis, toward the screen's readable side.
+func offsetZ(transform core.Matrix, z float32) core.Matrix {
+	return core.MatrixTranslate(0, 0, z).Multiply(transform)
+}
+
+// drawRect fills a rectangle in the screen's local space.
+func (tsr *TextScreenRenderer) drawRect(x, y, width, height float32, color core.Color, transform core.Matrix) {
+	tsr.renderer.fillPolygon3D(rectCorners(x, y, width, height, transform), color)
+}
+
+// drawRectOutline outlines a rectangle in the screen's local space.
+func (tsr *TextScreenRenderer) drawRectOutline(x, y, width, height float32, color core.Color, transform core.Matrix) {
+	corners := rectCorners(x, y, width, height, transform)
+	strokes := make([][2]core.Vec3, len(corners))
+	for i := range corners {
+		strokes[i] = [2]core.Vec3{corners[i], corners[(i+1)%len(corners)]}
+	}
+	tsr.renderer.drawStrokes(strokes, 0, color)
+}
+
+// rectCorners returns the world-space corners of a local rectangle.
+func rectCorners(x, y, width, height float32, transform core.Matrix) []core.Vec3 {
+	return []core.Vec3{
+		transform.TransformVec3(core.Vec3{X: x, Y: y}),
+		transform.TransformVec3(core.Vec3{X: x + width, Y: y}),
+		transform.TransformVec3(core.Vec3{X: x + width, Y: y + height}),
+		transform.TransformVec3(core.Vec3{X: x, Y: y + height}),
+	}
+}
+
+// lineRunes returns the runes of line in the renderer's scratch buffer,
+// which stays valid until the next call.
+func (tsr *TextScreenRenderer) lineRunes(line string) []rune {
+	tsr.runes = tsr.runes[:0]
+	for _, char := range line {
+		tsr.runes = append(tsr.runes, char)
+	}
+	return tsr.runes
+}
+
+// drawLine draws a line of text starting at position in the screen's local
+// space. startIndex is the rune index in the region's Text of the line's
+// first character, used to resolve span colors.
+func (tsr *TextScreenRenderer) drawLine(region *core.TextRegion, line string, startIndex int, position core.Vec3, scale float32, transform core.Matrix) {
+	offsets, lineWidth := region.VisualOffsets(line, scale)
+	charSpacing := (1.0 + region.CharSpacing) * scale
+
+	// Like the raylib backend, only the line origin is transformed; glyphs
+	// are laid out along world X from there
+	origin := transform.TransformVec3(position)
+
+	// Glyphs are placed from the far end of the line to compensate for the
+	// 180° Y rotation mirror effect; the offsets already account for the
+	// text direction
+	for i, char := range tsr.lineRunes(line) {
+		if char < 32 || char > 126 {
+			continue
+		}
+		glyph, exists := region.Font.Glyph(char)
+		if !exists || len(glyph.Strokes) == 0 {
+			continue
+		}
+
+		advance := lineWidth - offsets[i] - charSpacing
+		glyphPos := core.Vec3{X: origin.X + advance, Y: origin.Y, Z: origin.Z}
+
+		// Clipping happens in local space, where the glyph runs the other
+		// way from its origin
+		strokes := region.DashGlyphStrokes(core.GlyphStrokes(glyph, region.StrokeWeight()))
+		strokes = region.ClipGlyphStrokes(strokes, core.Vec2{X: position.X - advance, Y: position.Y}, scale)
+
+		if segments := strokeSegments(strokes, glyphPos, scale, core.MatrixIdentity()); len(segments) > 0 {
+			tsr.renderer.drawStrokes(segments, tsr.LineThickness, region.ColorAt(startIndex+i))
+		}
+	}
+}
+
+func (tsr *TextScreenRenderer) drawJustifiedLine(region *core.TextRegion, line string, startIndex int, x, y float32, scale float32, transform core.Matrix) {
+	words := strings.Split(line, " ")
+	if len(words) <= 1 {
+		tsr.drawLine(region, line, startIndex, core.Vec3{X: x, Y: y}, scale, transform)
+		return
+	}
+
+	// Rune index of each word within the region's Text
+	wordStarts := make([]int, len(words))
+	offset := startIndex
+	for i, word := range words {
+		wordStarts[i] = offset
+		offset += utf8.RuneCountInString(word) + 1
+	}
+
+	_, _, width, _ := region.InnerRect()
+	gap := region.JustifiedGap(words, scale)
+	// Start from the inner rect's right edge and work leftward, placing
+	// words from the end of a left-to-right line
+	xPos := x - width
+
+	for n := range words {
+		// Right-to-left lines place their first word at this end
+		i := len(words) - 1 - n
+		if region.Direction == core.DirectionRightToLeft {
+			i = n
+		}
+		word := words[i]
+		wordWidth := region.CalculateLineWidth(word, scale)
+
+		tsr.drawLine(region, word, wordStarts[i], core.Vec3{X: xPos + wordWidth, Y: y}, scale, transform)
+
+		xPos += wordWidth
+		if n < len(words)-1 {
+			xPos += gap
+		}
+	}
+}
+
+// drawLineBox draws the solid box standing in for a line drawn at y when
+// the region is simplified for distance. Justified lines span the full
+// inner width.
+func (tsr *TextScreenRenderer) drawLineBox(region *core.TextRegion, line string, y float32, justified bool, transform core.Matrix) {
+	box, ok := region.LineBox(line, y)
+	if !ok {
+		return
+	}
+	if justified {
+		box.X, _, box.Width, _ = region.InnerRect()
+	}
+	tsr.drawRect(box.X, box.Y, box.Width, box.Height, region.Color, transform)
+}
+
+// drawDecorations draws the region's underline and strikethrough for a line
+// drawn at y. Justified lines are stretched to the full inner
+// width, so their decorations span it too.
+func (tsr *TextScreenRenderer) drawDecorations(region *core.TextRegion, line string, y float32, justified bool, transform core.Matrix) {
+	innerX, _, innerWidth, _ := region.InnerRect()
+	for _, d := range region.LineDecorations(line, y) {
+		if justified {
+			d.StartX = innerX + innerWidth
+			d.EndX = innerX
+		}
+		start := transform.TransformVec3(core.Vec3{X: d.StartX, Y: d.Y})
+		end := transform.TransformVec3(core.Vec3{X: d.EndX, Y: d.Y})
+		tsr.renderer.drawLine3D(start, end, tsr.LineThickness, region.Color)
+	}
+}
+
+func (tsr *TextScreenRenderer) drawSection(section *core.TextSection) {
+	if section.Region == nil {
+		return
+	}
+
+	region := section.Region
+	screenTransform := region.Parent.GetViewTransformMatrix(tsr.renderer.Camera().Position)
+
+	if titleRegion, contentRegion := section.SubRegions(); titleRegion != nil {
+		tsr.DrawTextRegion(titleRegion, screenTransform, region.Parent.Scale)
+
+		if section.IsList() {
+			tsr.drawList(section, titleRegion.Y-section.TitleGap(), screenTransform)
+			return
+		}
+
+		tsr.DrawTextRegion(contentRegion, screenTransform, region.Parent.Scale)
+	} else if section.IsList() {
+		tsr.drawList(section, region.Y+region.Height, screenTransform)
+	} else {
+		region.Text = section.Content
+		region.Font = section.GetContentFont()
+		region.Color = section.Style.Color
+	}
+}
+
+// drawList draws a list section's items downward from top, each marker in
+// the column before its item's wrapped text.
+func (tsr *TextScreenRenderer) drawList(section *core.TextSection, top float32, transform core.Matrix) {
+	items, markers := section.ListRegions(top)
+	for i, item := range items {
+		tsr.DrawTextRegion(item, transform, section.Region.Parent.Scale)
+
+		if markers[i].Text == core.ListBullet {
+			tsr.drawBullet(markers[i], transform)
+		} else {
+			tsr.DrawTextRegion(markers[i], transform, section.Region.Parent.Scale)
+		}
+	}
+}
+
+// drawBullet draws a filled dot in place of the bullet glyph, which Hershey
+// fonts lack, centered on where an "o" would sit on the region's first line.
+func (tsr *TextScreenRenderer) drawBullet(region *core.TextRegion, transform core.Matrix) {
+	scale := region.Scale * region.Parent.Scale
+	baseline := region.CalculateStartY(float32(region.Font.Height) * scale)
+	dotWidth := region.CalculateLineWidth("o", scale)
+
+	center := core.Vec3{
+		X: region.CalculateLineX(dotWidth) - dotWidth/2,
+		Y: baseline + float32(region.Font.Height)*scale*0.25,
+		Z: region.Parent.TextZOffset,
+	}
+	radius := float32(region.Font.Height) * scale * 0.1
+
+	// The dot lies in the screen plane, which faces local +Z
+	points := core.CirclePoints(center, radius, core.Vec3{Z: 1}, 12)
+	for i, p := range points {
+		points[i] = transform.TransformVec3(p)
+	}
+	tsr.renderer.fillPolygon3D(points, region.Color)
+}
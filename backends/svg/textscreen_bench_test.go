@@ -0,0 +1,36 @@
+package svg
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/chazu/spectrex/core"
+)
+
+// BenchmarkTextScreenRenderer_DrawTextDocument measures redrawing a laid
+// out document of titled sections and lists frame after frame, as an
+// animated screen does. Run with -benchmem to see allocations per frame.
+func BenchmarkTextScreenRenderer_DrawTextDocument(b *testing.B) {
+	screen := core.NewTextScreen(core.Vec3{}, 800, 600, 1.0)
+	doc := core.NewTextDocument(screen, 2, 10)
+	doc.PageStyle.Font = core.LoadHersheyFontData()
+	doc.PageStyle.Color = core.ColorGreen
+	doc.PageStyle.Scale = 0.5
+	paragraph := strings.Repeat("The quick brown fox jumps over the lazy dog. ", 3)
+	for _, title := range []string{"Status", "Contacts", "Orders", "Log"} {
+		doc.AddSection(title, paragraph)
+	}
+	doc.AddSection("Tasks", "").SetList([]string{"Restock", paragraph, "Reply to the fox"}, true)
+	doc.AddSection("", "").SetList([]string{"Lazy", "Quick"}, false)
+	doc.Layout()
+
+	renderer := NewRenderer(800, 600)
+	tsr := NewTextScreenRenderer(renderer)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		renderer.BeginFrame()
+		tsr.DrawTextDocument(doc)
+	}
+}
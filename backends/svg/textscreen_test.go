@@ -0,0 +1,152 @@
+package svg
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chazu/spectrex/core"
+)
+
+func TestTextScreenRenderer_RightToLeft(t *testing.T) {
+	font := core.LoadHersheyFontData()
+
+	// meanX returns the mean projected X of each color's glyph strokes.
+	meanX := func(direction core.TextDirection) map[core.Color]float32 {
+		r := NewRenderer(200, 100)
+		r.Begin3D(core.Camera{
+			Position: core.Vec3{X: 0, Y: 0, Z: -100},
+			Target:   core.Vec3{X: 0, Y: 0, Z: 0},
+			Up:       core.Vec3{X: 0, Y: 1, Z: 0},
+			Fovy:     90,
+		})
+
+		screen := core.NewTextScreen(core.Vec3{}, 100, 40, 1.0)
+		region := screen.AddRegion(0, 0, 100, 40)
+		region.SetContent("HHH", font, core.ColorWhite)
+		region.Direction = direction
+		region.AddSpan(0, 1, core.ColorRed)
+		region.AddSpan(1, 2, core.ColorGreen)
+		region.AddSpan(2, 3, core.ColorBlue)
+		NewTextScreenRenderer(r).DrawTextScreen(screen)
+
+		sums := make(map[core.Color]float32)
+		counts := make(map[core.Color]int)
+		for _, s := range r.shapes {
+			if s.kind != shapePath {
+				continue
+			}
+			for _, p := range s.points {
+				sums[s.color] += p.X
+				counts[s.color]++
+			}
+		}
+		for color := range sums {
+			sums[color] /= float32(counts[color])
+		}
+		return sums
+	}
+
+	ltr := meanX(core.DirectionLeftToRight)
+	if !(ltr[core.ColorRed] < ltr[core.ColorGreen] && ltr[core.ColorGreen] < ltr[core.ColorBlue]) {
+		t.Errorf("left-to-right glyph X = red %g, green %g, blue %g; want increasing",
+			ltr[core.ColorRed], ltr[core.ColorGreen], ltr[core.ColorBlue])
+	}
+
+	rtl := meanX(core.DirectionRightToLeft)
+	if !(rtl[core.ColorRed] > rtl[core.ColorGreen] && rtl[core.ColorGreen] > rtl[core.ColorBlue]) {
+		t.Errorf("right-to-left glyph X = red %g, green %g, blue %g; want decreasing",
+			rtl[core.ColorRed], rtl[core.ColorGreen], rtl[core.ColorBlue])
+	}
+}
+
+func TestTextScreenRenderer_ClipText(t *testing.T) {
+	// The line's origin sits 4 units above the region, so without ClipText
+	// it is dropped; with it, the part below the top edge is drawn
+	draw := func(clip bool) *Renderer {
+		r := NewRenderer(200, 100)
+		r.Begin3D(core.Camera{
+			Position: core.Vec3{X: 0, Y: 0, Z: -100},
+			Target:   core.Vec3{X: 0, Y: 0, Z: 0},
+			Up:       core.Vec3{X: 0, Y: 1, Z: 0},
+			Fovy:     90,
+		})
+
+		screen := core.NewTextScreen(core.Vec3{}, 100, 40, 1.0)
+		region := screen.AddRegion(0, 0, 100, 40)
+		region.SetContent("H", core.LoadHersheyFontData(), core.ColorWhite)
+		region.ScrollOffset = 17
+		region.ClipText = clip
+		NewTextScreenRenderer(r).DrawTextScreen(screen)
+		return r
+	}
+
+	if r := draw(false); len(r.shapes) != 0 {
+		t.Errorf("unclipped line above the region drew %d shapes, want 0", len(r.shapes))
+	}
+
+	r := draw(true)
+	if len(r.shapes) != 1 {
+		t.Fatalf("clipped line drew %d shapes, want 1", len(r.shapes))
+	}
+	// Text is drawn TextZOffset in front of the screen, toward the camera
+	top, _ := r.project(core.Vec3{Y: 40, Z: -core.DefaultTextZOffset})
+	for _, p := range r.shapes[0].points {
+		if p.Y < top.Y-1e-3 {
+			t.Errorf("stroke point %v is above the region's top edge at y %g", p, top.Y)
+		}
+	}
+}
+
+func TestTextScreenRenderer_ZOffsets(t *testing.T) {
+	font := core.LoadHersheyFontData()
+
+	for _, offsets := range [][2]float32{
+		{core.DefaultTextZOffset, core.DefaultBorderZOffset},
+		{2, 1},
+	} {
+		r := NewRenderer(200, 100)
+		r.Begin3D(core.Camera{
+			Position: core.Vec3{X: 0, Y: 0, Z: -100},
+			Target:   core.Vec3{X: 0, Y: 0, Z: 0},
+			Up:       core.Vec3{X: 0, Y: 1, Z: 0},
+			Fovy:     90,
+		})
+
+		screen := core.NewTextScreen(core.Vec3{}, 100, 40, 1.0)
+		screen.TextZOffset, screen.BorderZOffset = offsets[0], offsets[1]
+		region := screen.AddRegion(0, 0, 100, 40)
+		region.SetContent("HI", font, core.ColorRed)
+		region.Transparent = false
+		region.ShowBorder = true
+		region.BorderColor = core.ColorBlue
+		// Drawn outside DrawTextScreen's group, which would flatten the
+		// shapes to one depth
+		NewTextScreenRenderer(r).DrawTextRegion(region, screen.GetTransformMatrix(), screen.Scale)
+
+		// The readable side faces the camera, so the offsets bring text
+		// and border closer than the background
+		var background float32
+		for _, s := range r.shapes {
+			if s.kind == shapePolygon {
+				background = s.depth
+			}
+		}
+		want := map[core.Color]float32{
+			core.ColorRed:  background - offsets[0],
+			core.ColorBlue: background - offsets[1],
+		}
+		seen := make(map[core.Color]bool)
+		for _, s := range r.shapes {
+			if s.kind != shapePath {
+				continue
+			}
+			seen[s.color] = true
+			if math.Abs(float64(s.depth-want[s.color])) > 1e-3 {
+				t.Errorf("offsets %v: %v path depth = %g, want %g", offsets, s.color, s.depth, want[s.color])
+			}
+		}
+		if !seen[core.ColorRed] || !seen[core.ColorBlue] {
+			t.Errorf("offsets %v: drew text %v, border %v; want both", offsets, seen[core.ColorRed], seen[core.ColorBlue])
+		}
+	}
+}
@@ -0,0 +1,391 @@
+// Package terminal provides a headless character-grid renderer for the
+// Spectrex framework. Primitives are projected through the camera and
+// rasterized into a grid of runes, which Flush prints to an io.Writer. It
+// needs no window or GPU, so it suits CI smoke tests and SSH demos.
+package terminal
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/chazu/spectrex/core"
+)
+
+// cell is one character of the grid.
+type cell struct {
+	ch    rune
+	color core.Color // Foreground; alpha 0 is the terminal default
+}
+
+// Renderer implements core.Renderer by rasterizing into a character grid.
+// There is no depth buffer: later primitives overwrite earlier ones.
+// Screen coordinates, such as for DrawText2D, are in cells.
+type Renderer struct {
+	Cols int
+	Rows int
+
+	// Out receives the grid on Flush. Defaults to os.Stdout.
+	Out io.Writer
+
+	// ANSI enables color escape codes in Flush, and moves the cursor home
+	// before each frame so successive flushes redraw in place.
+	ANSI bool
+
+	// CellAspect is the width of a character cell divided by its height,
+	// used so projected geometry keeps its proportions.
+	CellAspect float32
+
+	camera core.Camera
+	cells  []cell
+}
+
+// NewTerminalRenderer creates a renderer with a cols x rows character grid
+// that flushes to os.Stdout.
+func NewTerminalRenderer(cols, rows int) *Renderer {
+	r := &Renderer{
+		Cols:       cols,
+		Rows:       rows,
+		Out:        os.Stdout,
+		CellAspect: 0.5,
+		camera:     core.NewDefaultCamera(),
+		cells:      make([]cell, cols*rows),
+	}
+	r.clear()
+	return r
+}
+
+// clear fills the grid with blank cells.
+func (r *Renderer) clear() {
+	for i := range r.cells {
+		r.cells[i] = cell{ch: ' '}
+	}
+}
+
+// BeginFrame begins a new frame by clearing the grid.
+func (r *Renderer) BeginFrame() {
+	r.clear()
+}
+
+// EndFrame ends the current frame. The grid is kept until the next
+// BeginFrame; call Flush to print it.
+func (r *Renderer) EndFrame() {}
+
+// Begin3D sets the camera used to project the 3D primitives that follow.
+func (r *Renderer) Begin3D(camera core.Camera) {
+	r.camera = camera
+}
+
+// End3D ends 3D rendering.
+func (r *Renderer) End3D() {}
+
+// Camera returns the camera 3D primitives are projected with.
+func (r *Renderer) Camera() core.Camera {
+	return r.camera
+}
+
+// DrawLine3D draws a 3D line.
+func (r *Renderer) DrawLine3D(start, end core.Vec3, color core.Color) {
+	start, end, ok := r.camera.ClipSegment(start, end)
+	if !ok {
+		return
+	}
+	r.DrawLine2D(r.project(start), r.project(end), color)
+}
+
+// DrawTriangle3D fills a 3D triangle with blank cells, hiding whatever was
+// drawn behind it.
+func (r *Renderer) DrawTriangle3D(v1, v2, v3 core.Vec3, color core.Color) {
+	clipped := r.camera.ClipPolygon([]core.Vec3{v1, v2, v3})
+	if clipped == nil {
+		return
+	}
+
+	points := make([]core.Vec2, len(clipped))
+	for i, v := range clipped {
+		points[i] = r.project(v)
+	}
+	// A clipped triangle is convex, so fan it back into triangles
+	for i := 2; i < len(points); i++ {
+		r.fillTriangle(points[0], points[i-1], points[i])
+	}
+}
+
+// DrawPolygon3D fills a convex polygon as a fan of triangles. Polygons
+// with fewer than 3 vertices draw nothing.
+func (r *Renderer) DrawPolygon3D(vertices []core.Vec3, color core.Color) {
+	for _, tri := range core.FanTriangles(len(vertices)) {
+		r.DrawTriangle3D(vertices[tri[0]], vertices[tri[1]], vertices[tri[2]], color)
+	}
+}
+
+// DrawPolygonLines3D draws a polygon's closed outline.
+func (r *Renderer) DrawPolygonLines3D(vertices []core.Vec3, color core.Color) {
+	for _, edge := range core.PolygonEdges(len(vertices)) {
+		r.DrawLine3D(vertices[edge[0]], vertices[edge[1]], color)
+	}
+}
+
+// DrawCircle3D draws a circle outline as a loop of segments lines in the
+// plane facing normal. A zero normal draws on the XZ plane.
+func (r *Renderer) DrawCircle3D(center core.Vec3, radius float32, normal core.Vec3, segments int, color core.Color) {
+	points := core.CirclePoints(center, radius, normal, segments)
+	for i := range points {
+		r.DrawLine3D(points[i], points[(i+1)%len(points)], color)
+	}
+}
+
+// DrawArc3D draws an arc from startAngle to endAngle (degrees) as segments
+// lines in the plane facing normal. A zero normal draws on the XZ plane,
+// with angle 0 along +X.
+func (r *Renderer) DrawArc3D(center core.Vec3, radius, startAngle, endAngle float32, normal core.Vec3, segments int, color core.Color) {
+	points := core.ArcPoints(center, radius, startAngle, endAngle, normal, segments)
+	for i := 1; i < len(points); i++ {
+		r.DrawLine3D(points[i-1], points[i], color)
+	}
+}
+
+// DrawGrid draws a reference grid on the XZ plane centered on the origin,
+// matching raylib's DrawGrid.
+func (r *Renderer) DrawGrid(slices int, spacing float32) {
+	half := slices / 2
+	extent := float32(half) * spacing
+
+	for i := -half; i <= half; i++ {
+		color := core.Color{R: 191, G: 191, B: 191, A: 255}
+		if i == 0 {
+			color = core.Color{R: 127, G: 127, B: 127, A: 255}
+		}
+
+		offset := float32(i) * spacing
+		r.DrawLine3D(core.Vec3{X: offset, Z: -extent}, core.Vec3{X: offset, Z: extent}, color)
+		r.DrawLine3D(core.Vec3{X: -extent, Z: offset}, core.Vec3{X: extent, Z: offset}, color)
+	}
+}
+
+// DrawFPS does nothing; a terminal frame has no meaningful frame rate.
+func (r *Renderer) DrawFPS(x, y int32) {}
+
+// DrawText2D writes text into the grid starting at cell (x, y). The font
+// size is ignored; each rune takes one cell.
+func (r *Renderer) DrawText2D(text string, x, y int32, fontSize int32, color core.Color) {
+	for i, ch := range []rune(text) {
+		r.set(int(x)+i, int(y), ch, color)
+	}
+}
+
+// DrawLine2D rasterizes a line between two cell positions with Bresenham's
+// algorithm, using one of - | / \ depending on its slope. The part outside
+// the grid is skipped.
+func (r *Renderer) DrawLine2D(start, end core.Vec2, color core.Color) {
+	ch := lineRune(cellOf(end.X)-cellOf(start.X), cellOf(end.Y)-cellOf(start.Y))
+
+	start, end, ok := core.ClipSegmentToRect(start, end, 0, 0, float32(r.Cols), float32(r.Rows))
+	if !ok {
+		return
+	}
+	x0, y0 := cellOf(start.X), cellOf(start.Y)
+	x1, y1 := cellOf(end.X), cellOf(end.Y)
+
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+
+	err := dx + dy
+	for {
+		r.set(x0, y0, ch, color)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+// GetScreenWidth returns the grid width in cells.
+func (r *Renderer) GetScreenWidth() int32 {
+	return int32(r.Cols)
+}
+
+// GetScreenHeight returns the grid height in cells.
+func (r *Renderer) GetScreenHeight() int32 {
+	return int32(r.Rows)
+}
+
+// Rune returns the character at a cell, or 0 if it is outside the grid.
+func (r *Renderer) Rune(col, row int) rune {
+	if col < 0 || col >= r.Cols || row < 0 || row >= r.Rows {
+		return 0
+	}
+	return r.cells[row*r.Cols+col].ch
+}
+
+// String returns the grid as plain text, one line per row, without color.
+func (r *Renderer) String() string {
+	var b strings.Builder
+	for row := 0; row < r.Rows; row++ {
+		for _, c := range r.cells[row*r.Cols : (row+1)*r.Cols] {
+			b.WriteRune(c.ch)
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// Flush prints the grid to Out, with color escape codes if ANSI is set.
+func (r *Renderer) Flush() error {
+	if !r.ANSI {
+		_, err := io.WriteString(r.Out, r.String())
+		return err
+	}
+
+	w := bufio.NewWriter(r.Out)
+	w.WriteString("\x1b[H")
+	for row := 0; row < r.Rows; row++ {
+		current := -1
+		for _, c := range r.cells[row*r.Cols : (row+1)*r.Cols] {
+			if code := ansiColor(c.color); code != current {
+				fmt.Fprintf(w, "\x1b[%dm", code)
+				current = code
+			}
+			w.WriteRune(c.ch)
+		}
+		w.WriteString("\x1b[0m\n")
+	}
+	return w.Flush()
+}
+
+// aspect returns the grid's displayed width divided by its height.
+func (r *Renderer) aspect() float32 {
+	if r.Rows == 0 {
+		return 1
+	}
+	return float32(r.Cols) * r.CellAspect / float32(r.Rows)
+}
+
+// project maps a world point, already clipped to the near plane, to cell
+// coordinates.
+func (r *Renderer) project(point core.Vec3) core.Vec2 {
+	ndc, _, _ := r.camera.Project(point, r.aspect())
+	return core.Vec2{
+		X: (ndc.X + 1) / 2 * float32(r.Cols),
+		Y: (1 - ndc.Y) / 2 * float32(r.Rows),
+	}
+}
+
+// set writes a character to a cell, ignoring cells outside the grid.
+func (r *Renderer) set(col, row int, ch rune, color core.Color) {
+	if col < 0 || col >= r.Cols || row < 0 || row >= r.Rows {
+		return
+	}
+	r.cells[row*r.Cols+col] = cell{ch: ch, color: color}
+}
+
+// fillTriangle blanks every cell whose center lies inside the triangle.
+func (r *Renderer) fillTriangle(a, b, c core.Vec2) {
+	minX := int(math.Floor(float64(min(a.X, b.X, c.X))))
+	maxX := int(math.Ceil(float64(max(a.X, b.X, c.X))))
+	minY := int(math.Floor(float64(min(a.Y, b.Y, c.Y))))
+	maxY := int(math.Ceil(float64(max(a.Y, b.Y, c.Y))))
+	minX, maxX = max(minX, 0), min(maxX, r.Cols-1)
+	minY, maxY = max(minY, 0), min(maxY, r.Rows-1)
+
+	for row := minY; row <= maxY; row++ {
+		for col := minX; col <= maxX; col++ {
+			p := core.Vec2{X: float32(col) + 0.5, Y: float32(row) + 0.5}
+			if insideTriangle(p, a, b, c) {
+				r.set(col, row, ' ', core.Color{})
+			}
+		}
+	}
+}
+
+// insideTriangle reports whether p lies inside triangle abc of either
+// winding, edges included.
+func insideTriangle(p, a, b, c core.Vec2) bool {
+	d1 := cross2(a, b, p)
+	d2 := cross2(b, c, p)
+	d3 := cross2(c, a, p)
+	hasNeg := d1 < 0 || d2 < 0 || d3 < 0
+	hasPos := d1 > 0 || d2 > 0 || d3 > 0
+	return !(hasNeg && hasPos)
+}
+
+// cross2 returns the z component of (b-a) x (p-a).
+func cross2(a, b, p core.Vec2) float32 {
+	return (b.X-a.X)*(p.Y-a.Y) - (b.Y-a.Y)*(p.X-a.X)
+}
+
+// cellOf returns the index of the cell containing a coordinate.
+func cellOf(v float32) int {
+	return int(math.Floor(float64(v)))
+}
+
+// lineRune picks the character for a line with the given cell deltas.
+// Rows grow downward, so a line going down and right is drawn with \.
+func lineRune(dx, dy int) rune {
+	adx, ady := abs(dx), abs(dy)
+	switch {
+	case adx == 0 && ady == 0:
+		return '.'
+	case ady*2 < adx:
+		return '-'
+	case adx*2 < ady:
+		return '|'
+	case (dx > 0) == (dy > 0):
+		return '\\'
+	default:
+		return '/'
+	}
+}
+
+// ansiPalette holds the 16 standard ANSI colors, indexed by their offset
+// from the normal (30) and bright (90) foreground codes.
+var ansiPalette = [16]core.Color{
+	{R: 0, G: 0, B: 0}, {R: 170, G: 0, B: 0}, {R: 0, G: 170, B: 0}, {R: 170, G: 85, B: 0},
+	{R: 0, G: 0, B: 170}, {R: 170, G: 0, B: 170}, {R: 0, G: 170, B: 170}, {R: 170, G: 170, B: 170},
+	{R: 85, G: 85, B: 85}, {R: 255, G: 85, B: 85}, {R: 85, G: 255, B: 85}, {R: 255, G: 255, B: 85},
+	{R: 85, G: 85, B: 255}, {R: 255, G: 85, B: 255}, {R: 85, G: 255, B: 255}, {R: 255, G: 255, B: 255},
+}
+
+// ansiColor returns the foreground escape code of the ANSI color nearest
+// to c, or the default foreground code for a transparent color.
+func ansiColor(c core.Color) int {
+	if c.A == 0 {
+		return 39
+	}
+	best, bestDist := 0, math.MaxInt
+	for i, p := range ansiPalette {
+		dr, dg, db := int(c.R)-int(p.R), int(c.G)-int(p.G), int(c.B)-int(p.B)
+		if dist := dr*dr + dg*dg + db*db; dist < bestDist {
+			best, bestDist = i, dist
+		}
+	}
+	if best < 8 {
+		return 30 + best
+	}
+	return 90 + best - 8
+}
+
+// abs returns the absolute value of an int.
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
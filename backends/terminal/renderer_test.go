@@ -0,0 +1,110 @@
+package terminal
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/chazu/spectrex/core"
+)
+
+func TestRenderer_DiagonalLine(t *testing.T) {
+	r := NewTerminalRenderer(6, 4)
+	r.DrawLine2D(core.Vec2{X: 0.5, Y: 0.5}, core.Vec2{X: 3.5, Y: 3.5}, core.ColorWhite)
+	r.DrawLine2D(core.Vec2{X: 5.5, Y: 0.5}, core.Vec2{X: 2.5, Y: 3.5}, core.ColorWhite)
+
+	want := "" +
+		"\\    /\n" +
+		" \\  / \n" +
+		"  \\/  \n" +
+		"  /\\  \n"
+	if got := r.String(); got != want {
+		t.Errorf("diagonal lines:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRenderer_LineRunes(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end core.Vec2
+		want       string
+	}{
+		{"horizontal", core.Vec2{X: 0.5, Y: 1.5}, core.Vec2{X: 4.5, Y: 1.5}, "     \n-----\n     \n"},
+		{"vertical", core.Vec2{X: 2.5, Y: 0.5}, core.Vec2{X: 2.5, Y: 2.5}, "  |  \n  |  \n  |  \n"},
+		{"point", core.Vec2{X: 1.5, Y: 1.5}, core.Vec2{X: 1.5, Y: 1.5}, "     \n .   \n     \n"},
+		{"clipped", core.Vec2{X: -10.5, Y: 2.5}, core.Vec2{X: 100.5, Y: 2.5}, "     \n     \n-----\n"},
+		{"off grid", core.Vec2{X: -10, Y: -1}, core.Vec2{X: 10, Y: -1}, "     \n     \n     \n"},
+	}
+
+	for _, tt := range tests {
+		r := NewTerminalRenderer(5, 3)
+		r.DrawLine2D(tt.start, tt.end, core.ColorWhite)
+		if got := r.String(); got != tt.want {
+			t.Errorf("%s:\ngot:\n%s\nwant:\n%s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRenderer_Projected(t *testing.T) {
+	// An orthographic camera looking down -Z with a 10 unit tall view maps
+	// world X/Y in [-5, 5] onto the grid; CellAspect 1 keeps cells square
+	r := NewTerminalRenderer(10, 10)
+	r.CellAspect = 1
+	r.Begin3D(core.Camera{
+		Position:   core.Vec3{X: 0, Y: 0, Z: 10},
+		Target:     core.Vec3{X: 0, Y: 0, Z: 0},
+		Up:         core.Vec3{X: 0, Y: 1, Z: 0},
+		Fovy:       10,
+		Projection: 1,
+	})
+	r.DrawLine3D(core.Vec3{X: -4.5, Y: 4.5}, core.Vec3{X: 4.5, Y: -4.5}, core.ColorWhite)
+
+	// World +Y is up, so the line runs from the top left to the bottom right
+	for i := 0; i < 10; i++ {
+		if got := r.Rune(i, i); got != '\\' {
+			t.Errorf("Rune(%d, %d) = %q, want '\\\\'\n%s", i, i, got, r.String())
+		}
+	}
+
+	// A triangle blanks the cells it covers
+	r.DrawTriangle3D(core.Vec3{X: -5, Y: 5}, core.Vec3{X: 5, Y: 5}, core.Vec3{X: 5, Y: -5}, core.ColorRed)
+	if got := r.Rune(9, 0); got != ' ' {
+		t.Errorf("Rune(9, 0) under triangle = %q, want ' '", got)
+	}
+	if got := r.Rune(0, 9); got != ' ' {
+		t.Errorf("Rune(0, 9) outside triangle = %q, want ' '", got)
+	}
+}
+
+func TestRenderer_Flush(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewTerminalRenderer(4, 2)
+	r.Out = &buf
+	r.DrawText2D("hi", 1, 0, 10, core.ColorRed)
+	r.DrawText2D("toolong", 2, 1, 10, core.Color{})
+
+	if err := r.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	if got, want := buf.String(), " hi \n  to\n"; got != want {
+		t.Errorf("plain Flush = %q, want %q", got, want)
+	}
+
+	buf.Reset()
+	r.ANSI = true
+	if err := r.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	got := buf.String()
+	if !strings.HasPrefix(got, "\x1b[H") {
+		t.Errorf("ANSI Flush should start by moving the cursor home: %q", got)
+	}
+	if !strings.Contains(got, "\x1b[31mhi\x1b[39m") {
+		t.Errorf("ANSI Flush should color the red text: %q", got)
+	}
+
+	r.BeginFrame()
+	if got := r.String(); got != "    \n    \n" {
+		t.Errorf("BeginFrame should clear the grid, got %q", got)
+	}
+}
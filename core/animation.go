@@ -1,6 +1,8 @@
 // Package core provides animation capabilities for the Spectrex framework.
 package core
 
+import "math"
+
 // AnimationType defines the type of property being animated.
 type AnimationType int
 
@@ -9,6 +11,7 @@ const (
 	AnimationTypePosition
 	AnimationTypeColor
 	AnimationTypeScale
+	AnimationTypeFloat // A single float32, such as a scale factor or alpha
 )
 
 // EaseType defines the easing function type.
@@ -19,6 +22,10 @@ const (
 	EaseInOut
 	EaseIn
 	EaseOut
+	EaseInOutCubic
+	EaseOutBounce
+	EaseOutElastic
+	EaseOutBack
 )
 
 // Animation represents an animation on an object property.
@@ -32,63 +39,255 @@ type Animation struct {
 	Timer        float32
 	Completed    bool
 	EaseType     EaseType
+
+	// ColorSpace selects how AnimationTypeColor interpolates. The default,
+	// ColorSpaceSRGB, blends the raw channels.
+	ColorSpace ColorSpace
+
+	// StartDelay holds the animation at its start value for this many
+	// seconds before the timer begins advancing.
+	StartDelay float32
+
+	// OnComplete, if set, is called once when the animation completes.
+	OnComplete func()
+
+	// Apply, if set, is called with CurrentValue every time it is updated,
+	// allowing the animation to write directly to its target.
+	Apply func(value interface{})
+
+	delayElapsed float32
+}
+
+// Update advances the animation by deltaTime and recomputes CurrentValue.
+// Completed animations are left unchanged.
+func (anim *Animation) Update(deltaTime float32) {
+	if anim.Completed {
+		return
+	}
+
+	if anim.delayElapsed < anim.StartDelay {
+		anim.delayElapsed += deltaTime
+		if anim.delayElapsed < anim.StartDelay {
+			anim.CurrentValue = anim.StartValue
+			if anim.Apply != nil {
+				anim.Apply(anim.CurrentValue)
+			}
+			return
+		}
+		// Carry the time left over after the delay into the animation
+		deltaTime = anim.delayElapsed - anim.StartDelay
+	}
+
+	anim.Timer += deltaTime
+
+	progress := anim.Timer / anim.Duration
+	if progress >= 1.0 {
+		progress = 1.0
+		anim.Completed = true
+	}
+
+	easedProgress := applyEasing(progress, anim.EaseType)
+
+	switch anim.Type {
+	case AnimationTypeRotation, AnimationTypePosition, AnimationTypeScale:
+		anim.CurrentValue = lerpVec3(anim.StartValue.(Vec3), anim.EndValue.(Vec3), easedProgress)
+
+	case AnimationTypeColor:
+		anim.CurrentValue = lerpColorSpace(anim.StartValue.(Color), anim.EndValue.(Color), easedProgress, anim.ColorSpace)
+
+	case AnimationTypeFloat:
+		anim.CurrentValue = lerpFloat(anim.StartValue.(float32), anim.EndValue.(float32), easedProgress)
+	}
+
+	if anim.Apply != nil {
+		anim.Apply(anim.CurrentValue)
+	}
+
+	if anim.Completed && anim.OnComplete != nil {
+		anim.OnComplete()
+	}
+}
+
+// Sequence plays a list of animations one after another.
+// Each animation starts only once the previous one has completed.
+type Sequence struct {
+	Animations []*Animation
+	current    int
+}
+
+// NewSequence creates a new sequence from the given animations.
+func NewSequence(anims ...*Animation) *Sequence {
+	return &Sequence{
+		Animations: append(make([]*Animation, 0, len(anims)), anims...),
+	}
+}
+
+// Add appends an animation to the end of the sequence.
+func (s *Sequence) Add(anim *Animation) {
+	s.Animations = append(s.Animations, anim)
+}
+
+// Current returns the animation currently playing, or nil if the sequence
+// has completed.
+func (s *Sequence) Current() *Animation {
+	if s.current >= len(s.Animations) {
+		return nil
+	}
+	return s.Animations[s.current]
+}
+
+// Completed returns true once every animation in the sequence has completed.
+func (s *Sequence) Completed() bool {
+	return s.current >= len(s.Animations)
+}
+
+// Update advances the current animation, moving on to the next one when
+// it completes.
+func (s *Sequence) Update(deltaTime float32) {
+	anim := s.Current()
+	if anim == nil {
+		return
+	}
+
+	anim.Update(deltaTime)
+	if anim.Completed {
+		s.current++
+	}
+}
+
+// Spring defaults: how close to rest a spring must come to settle, and the
+// longest time step it integrates at once, for stability at low frame rates.
+const (
+	DefaultSpringThreshold = 0.001
+	springMaxStep          = 1.0 / 120
+)
+
+// SpringAnimation moves a value toward a target like a damped spring.
+// Unlike Animation it has no duration: the target may move at any time and
+// the value follows from its current velocity, settling once it comes to
+// rest at the target.
+type SpringAnimation struct {
+	Value    Vec3
+	Velocity Vec3
+	Target   Vec3
+
+	// Stiffness pulls the value toward the target; Damping resists its
+	// velocity. Damping of CriticalDamping(Stiffness) settles fastest
+	// without overshooting.
+	Stiffness float32
+	Damping   float32
+
+	// Threshold is the distance from the target and the speed below which
+	// the spring settles.
+	Threshold float32
+
+	// Apply, if set, is called with Value every time it is updated.
+	Apply func(value Vec3)
+
+	settled bool
+}
+
+// NewSpringAnimation creates a critically damped spring at start, chasing
+// target.
+func NewSpringAnimation(start, target Vec3, stiffness float32) *SpringAnimation {
+	return &SpringAnimation{
+		Value:     start,
+		Target:    target,
+		Stiffness: stiffness,
+		Damping:   CriticalDamping(stiffness),
+		Threshold: DefaultSpringThreshold,
+	}
+}
+
+// CriticalDamping returns the damping at which a spring of the given
+// stiffness settles fastest without overshooting.
+func CriticalDamping(stiffness float32) float32 {
+	return 2 * float32(math.Sqrt(float64(max(stiffness, 0))))
+}
+
+// SetTarget moves the target, waking the spring if it had settled.
+func (s *SpringAnimation) SetTarget(target Vec3) {
+	s.Target = target
+	s.settled = false
+}
+
+// Settled reports whether the spring has come to rest at its target.
+func (s *SpringAnimation) Settled() bool {
+	return s.settled
+}
+
+// Update advances the spring by deltaTime. Settled springs are left
+// unchanged until their target moves.
+func (s *SpringAnimation) Update(deltaTime float32) {
+	if s.settled || deltaTime <= 0 {
+		return
+	}
+
+	// Semi-implicit Euler in small steps stays stable for stiff springs
+	for deltaTime > 0 {
+		dt := min(deltaTime, springMaxStep)
+		deltaTime -= dt
+
+		force := s.Target.Sub(s.Value).Scale(s.Stiffness).Sub(s.Velocity.Scale(s.Damping))
+		s.Velocity = s.Velocity.Add(force.Scale(dt))
+		s.Value = s.Value.Add(s.Velocity.Scale(dt))
+	}
+
+	if s.Target.Sub(s.Value).Length() < s.Threshold && s.Velocity.Length() < s.Threshold {
+		s.Value = s.Target
+		s.Velocity = Vec3{}
+		s.settled = true
+	}
+
+	if s.Apply != nil {
+		s.Apply(s.Value)
+	}
 }
 
 // AnimationManager handles all active animations.
 type AnimationManager struct {
 	Animations []*Animation
+	Sequences  []*Sequence
+
+	// Springs are updated every frame and never removed, since their
+	// targets may move again after they settle.
+	Springs []*SpringAnimation
 }
 
 // NewAnimationManager creates a new animation manager.
 func NewAnimationManager() *AnimationManager {
 	return &AnimationManager{
 		Animations: make([]*Animation, 0),
+		Sequences:  make([]*Sequence, 0),
 	}
 }
 
-// Update updates all animations.
+// Update updates all animations, sequences and springs.
 func (am *AnimationManager) Update(deltaTime float32) {
-	for i := 0; i < len(am.Animations); i++ {
-		anim := am.Animations[i]
-
-		if anim.Completed {
-			continue
-		}
-
-		anim.Timer += deltaTime
-
-		progress := anim.Timer / anim.Duration
-		if progress >= 1.0 {
-			progress = 1.0
-			anim.Completed = true
-		}
-
-		easedProgress := applyEasing(progress, anim.EaseType)
-
-		switch anim.Type {
-		case AnimationTypeRotation, AnimationTypePosition, AnimationTypeScale:
-			startVal := anim.StartValue.(Vec3)
-			endVal := anim.EndValue.(Vec3)
-			anim.CurrentValue = Vec3{
-				X: startVal.X + (endVal.X-startVal.X)*easedProgress,
-				Y: startVal.Y + (endVal.Y-startVal.Y)*easedProgress,
-				Z: startVal.Z + (endVal.Z-startVal.Z)*easedProgress,
-			}
+	for _, anim := range am.Animations {
+		anim.Update(deltaTime)
+	}
+	for _, seq := range am.Sequences {
+		seq.Update(deltaTime)
+	}
+	for _, spring := range am.Springs {
+		spring.Update(deltaTime)
+	}
 
-		case AnimationTypeColor:
-			startVal := anim.StartValue.(Color)
-			endVal := anim.EndValue.(Color)
-			anim.CurrentValue = Color{
-				R: uint8(float32(startVal.R) + (float32(endVal.R)-float32(startVal.R))*easedProgress),
-				G: uint8(float32(startVal.G) + (float32(endVal.G)-float32(startVal.G))*easedProgress),
-				B: uint8(float32(startVal.B) + (float32(endVal.B)-float32(startVal.B))*easedProgress),
-				A: uint8(float32(startVal.A) + (float32(endVal.A)-float32(startVal.A))*easedProgress),
-			}
+	// Remove completed sequences
+	i := 0
+	for i < len(am.Sequences) {
+		if am.Sequences[i].Completed() {
+			lastIdx := len(am.Sequences) - 1
+			am.Sequences[i] = am.Sequences[lastIdx]
+			am.Sequences = am.Sequences[:lastIdx]
+		} else {
+			i++
 		}
 	}
 
 	// Remove completed animations
-	i := 0
+	i = 0
 	for i < len(am.Animations) {
 		if am.Animations[i].Completed {
 			lastIdx := len(am.Animations) - 1
@@ -105,6 +304,17 @@ func (am *AnimationManager) AddAnimation(anim *Animation) {
 	am.Animations = append(am.Animations, anim)
 }
 
+// AddSequence adds a sequence to be driven by the manager.
+// Animations in the sequence should not also be added with AddAnimation.
+func (am *AnimationManager) AddSequence(seq *Sequence) {
+	am.Sequences = append(am.Sequences, seq)
+}
+
+// AddSpring adds a spring to be driven by the manager.
+func (am *AnimationManager) AddSpring(spring *SpringAnimation) {
+	am.Springs = append(am.Springs, spring)
+}
+
 // SimpleRotation creates a simple rotation animation.
 func (am *AnimationManager) SimpleRotation(target interface{}, axis string, startAngle, endAngle, duration float32) *Animation {
 	startVal := Vec3{}
@@ -138,6 +348,137 @@ func (am *AnimationManager) SimpleRotation(target interface{}, axis string, star
 	return anim
 }
 
+// EvaluateTween returns the eased value between start and end at absolute
+// time t, without registering an animation. t is clamped to [0, duration].
+// Supports Vec3, Color and float32 values; returns nil for other types or
+// if start and end have different types.
+func EvaluateTween(start, end interface{}, duration, t float32, ease EaseType) interface{} {
+	progress := float32(1.0)
+	if duration > 0 {
+		if t < 0 {
+			t = 0
+		} else if t > duration {
+			t = duration
+		}
+		progress = t / duration
+	}
+	easedProgress := applyEasing(progress, ease)
+
+	switch startVal := start.(type) {
+	case Vec3:
+		if endVal, ok := end.(Vec3); ok {
+			return lerpVec3(startVal, endVal, easedProgress)
+		}
+	case Color:
+		if endVal, ok := end.(Color); ok {
+			return lerpColor(startVal, endVal, easedProgress)
+		}
+	case float32:
+		if endVal, ok := end.(float32); ok {
+			return lerpFloat(startVal, endVal, easedProgress)
+		}
+	}
+	return nil
+}
+
+// lerpFloat linearly interpolates between two values.
+func lerpFloat(a, b, t float32) float32 {
+	return a + (b-a)*t
+}
+
+// lerpVec3 linearly interpolates between two vectors.
+func lerpVec3(a, b Vec3, t float32) Vec3 {
+	return Vec3{
+		X: a.X + (b.X-a.X)*t,
+		Y: a.Y + (b.Y-a.Y)*t,
+		Z: a.Z + (b.Z-a.Z)*t,
+	}
+}
+
+// lerpColor linearly interpolates between two colors, including alpha.
+// Channels are clamped so overshooting easing curves cannot wrap around.
+func lerpColor(a, b Color, t float32) Color {
+	return Color{
+		R: lerpChannel(a.R, b.R, t),
+		G: lerpChannel(a.G, b.G, t),
+		B: lerpChannel(a.B, b.B, t),
+		A: lerpChannel(a.A, b.A, t),
+	}
+}
+
+// lerpChannel interpolates a single color channel, clamped to [0, 255].
+func lerpChannel(a, b uint8, t float32) uint8 {
+	v := float32(a) + (float32(b)-float32(a))*t
+	if v <= 0 {
+		return 0
+	}
+	if v >= 255 {
+		return 255
+	}
+	return uint8(v)
+}
+
+// AnimateScreenRotation creates an animation that rotates a text screen from
+// its current rotation to endRotation (in degrees), updating the screen's
+// Rotation each tick.
+func (am *AnimationManager) AnimateScreenRotation(screen *TextScreen, endRotation Vec3, duration float32) *Animation {
+	anim := &Animation{
+		Type:         AnimationTypeRotation,
+		Target:       screen,
+		StartValue:   screen.Rotation,
+		EndValue:     endRotation,
+		CurrentValue: screen.Rotation,
+		Duration:     duration,
+		EaseType:     EaseLinear,
+		Apply: func(value interface{}) {
+			screen.Rotation = value.(Vec3)
+		},
+	}
+
+	am.AddAnimation(anim)
+	return anim
+}
+
+// AnimateScreenPosition creates an animation that moves a text screen from
+// its current position to endPosition, updating the screen's Position each tick.
+func (am *AnimationManager) AnimateScreenPosition(screen *TextScreen, endPosition Vec3, duration float32) *Animation {
+	anim := &Animation{
+		Type:         AnimationTypePosition,
+		Target:       screen,
+		StartValue:   screen.Position,
+		EndValue:     endPosition,
+		CurrentValue: screen.Position,
+		Duration:     duration,
+		EaseType:     EaseLinear,
+		Apply: func(value interface{}) {
+			screen.Position = value.(Vec3)
+		},
+	}
+
+	am.AddAnimation(anim)
+	return anim
+}
+
+// AnimateFloat creates an animation of a single value from from to to,
+// passing the eased value to setter each tick, such as to tween a region's
+// Scale or a camera's Fovy.
+func (am *AnimationManager) AnimateFloat(setter func(float32), from, to, duration float32) *Animation {
+	anim := &Animation{
+		Type:         AnimationTypeFloat,
+		StartValue:   from,
+		EndValue:     to,
+		CurrentValue: from,
+		Duration:     duration,
+		EaseType:     EaseLinear,
+		Apply: func(value interface{}) {
+			setter(value.(float32))
+		},
+	}
+
+	am.AddAnimation(anim)
+	return anim
+}
+
 // applyEasing applies the easing function to a progress value.
 func applyEasing(progress float32, easeType EaseType) float32 {
 	switch easeType {
@@ -150,7 +491,48 @@ func applyEasing(progress float32, easeType EaseType) float32 {
 		return progress * progress
 	case EaseOut:
 		return 1 - ((1 - progress) * (1 - progress))
+	case EaseInOutCubic:
+		if progress < 0.5 {
+			return 4 * progress * progress * progress
+		}
+		p := -2*progress + 2
+		return 1 - p*p*p/2
+	case EaseOutBounce:
+		return easeOutBounce(progress)
+	case EaseOutElastic:
+		if progress <= 0 || progress >= 1 {
+			return progress
+		}
+		const c4 = 2 * math.Pi / 3
+		p := float64(progress)
+		return float32(math.Pow(2, -10*p)*math.Sin((p*10-0.75)*c4) + 1)
+	case EaseOutBack:
+		// Overshoots past 1 before settling
+		const c1 = 1.70158
+		const c3 = c1 + 1
+		p := progress - 1
+		return 1 + c3*p*p*p + c1*p*p
 	default:
 		return progress
 	}
 }
+
+// easeOutBounce returns a curve that bounces to rest at 1.
+func easeOutBounce(progress float32) float32 {
+	const n1 = 7.5625
+	const d1 = 2.75
+
+	switch {
+	case progress < 1/d1:
+		return n1 * progress * progress
+	case progress < 2/d1:
+		progress -= 1.5 / d1
+		return n1*progress*progress + 0.75
+	case progress < 2.5/d1:
+		progress -= 2.25 / d1
+		return n1*progress*progress + 0.9375
+	default:
+		progress -= 2.625 / d1
+		return n1*progress*progress + 0.984375
+	}
+}
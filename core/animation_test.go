@@ -0,0 +1,328 @@
+package core
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestAnimationManager_OnCompleteCalledOnce(t *testing.T) {
+	am := NewAnimationManager()
+	calls := 0
+	anim := am.SimpleRotation(nil, "y", 0, 90, 1.0)
+	anim.OnComplete = func() {
+		calls++
+		// The animation must still be registered when the callback runs
+		if len(am.Animations) != 1 {
+			t.Errorf("OnComplete ran with %d animations registered, want 1", len(am.Animations))
+		}
+	}
+
+	am.Update(0.5)
+	if calls != 0 {
+		t.Fatalf("OnComplete called %d times before completion, want 0", calls)
+	}
+
+	for i := 0; i < 5; i++ {
+		am.Update(0.5)
+	}
+	if calls != 1 {
+		t.Errorf("OnComplete called %d times, want 1", calls)
+	}
+	if !anim.Completed {
+		t.Error("animation should be completed")
+	}
+	if len(am.Animations) != 0 {
+		t.Errorf("%d animations remain, want 0", len(am.Animations))
+	}
+}
+
+func TestAnimationManager_StartDelay(t *testing.T) {
+	am := NewAnimationManager()
+	anim := am.SimpleRotation(nil, "x", 0, 100, 1.0)
+	anim.StartDelay = 0.5
+
+	am.Update(0.25)
+	if anim.Timer != 0 {
+		t.Errorf("Timer = %f during delay, want 0", anim.Timer)
+	}
+	if got := anim.CurrentValue.(Vec3); got.X != 0 {
+		t.Errorf("CurrentValue.X = %f during delay, want 0", got.X)
+	}
+
+	// Crosses the end of the delay; the remaining 0.25s advances the timer
+	am.Update(0.5)
+	if anim.Timer != 0.25 {
+		t.Errorf("Timer = %f after delay, want 0.25", anim.Timer)
+	}
+	if got := anim.CurrentValue.(Vec3); got.X != 25 {
+		t.Errorf("CurrentValue.X = %f after delay, want 25", got.X)
+	}
+
+	am.Update(1.0)
+	if !anim.Completed {
+		t.Error("animation should be completed")
+	}
+}
+
+func TestEvaluateTween_MatchesManagedAnimation(t *testing.T) {
+	start := Vec3{X: 0, Y: 10, Z: -20}
+	end := Vec3{X: 100, Y: 20, Z: 20}
+
+	am := NewAnimationManager()
+	anim := &Animation{
+		Type:         AnimationTypePosition,
+		StartValue:   start,
+		EndValue:     end,
+		CurrentValue: start,
+		Duration:     2.0,
+		EaseType:     EaseInOut,
+	}
+	am.AddAnimation(anim)
+	am.Update(0.5)
+	am.Update(0.25)
+
+	got := EvaluateTween(start, end, 2.0, 0.75, EaseInOut)
+	if got != anim.CurrentValue {
+		t.Errorf("EvaluateTween = %v, managed animation = %v", got, anim.CurrentValue)
+	}
+}
+
+func TestEvaluateTween_Color(t *testing.T) {
+	start := Color{R: 0, G: 0, B: 0, A: 0}
+	end := Color{R: 200, G: 100, B: 50, A: 255}
+
+	got := EvaluateTween(start, end, 1.0, 0.5, EaseLinear)
+	want := Color{R: 100, G: 50, B: 25, A: 127}
+	if got != want {
+		t.Errorf("EvaluateTween(0.5) = %v, want %v", got, want)
+	}
+}
+
+func TestEvaluateTween_Clamps(t *testing.T) {
+	start := Vec3{X: 0}
+	end := Vec3{X: 10}
+
+	if got := EvaluateTween(start, end, 1.0, -1.0, EaseLinear); got != start {
+		t.Errorf("EvaluateTween(t<0) = %v, want %v", got, start)
+	}
+	if got := EvaluateTween(start, end, 1.0, 5.0, EaseLinear); got != end {
+		t.Errorf("EvaluateTween(t>duration) = %v, want %v", got, end)
+	}
+	if got := EvaluateTween(start, Color{}, 1.0, 0.5, EaseLinear); got != nil {
+		t.Errorf("EvaluateTween with mismatched types = %v, want nil", got)
+	}
+}
+
+func TestSequence_AdvancesOnCompletion(t *testing.T) {
+	first := &Animation{
+		Type:       AnimationTypePosition,
+		StartValue: Vec3{},
+		EndValue:   Vec3{X: 10},
+		Duration:   1.0,
+	}
+	second := &Animation{
+		Type:       AnimationTypeColor,
+		StartValue: ColorBlack,
+		EndValue:   ColorWhite,
+		Duration:   1.0,
+	}
+
+	seq := NewSequence()
+	seq.Add(first)
+	seq.Add(second)
+
+	am := NewAnimationManager()
+	am.AddSequence(seq)
+
+	for i := 0; i < 3; i++ {
+		am.Update(0.3)
+		if first.Completed {
+			t.Fatalf("first animation completed early at tick %d", i)
+		}
+		if second.Timer != 0 {
+			t.Errorf("second Timer = %f while first is running, want 0", second.Timer)
+		}
+	}
+
+	am.Update(0.3)
+	if !first.Completed {
+		t.Fatal("first animation should be completed")
+	}
+	if second.Timer != 0 {
+		t.Errorf("second Timer = %f on the tick first completed, want 0", second.Timer)
+	}
+	if seq.Current() != second {
+		t.Error("Current() should be the second animation")
+	}
+
+	am.Update(0.5)
+	if second.Timer != 0.5 {
+		t.Errorf("second Timer = %f, want 0.5", second.Timer)
+	}
+
+	am.Update(0.5)
+	if !seq.Completed() {
+		t.Error("sequence should be completed")
+	}
+	if len(am.Sequences) != 0 {
+		t.Errorf("%d sequences remain, want 0", len(am.Sequences))
+	}
+}
+
+func TestApplyEasing_Curves(t *testing.T) {
+	tests := []struct {
+		name string
+		ease EaseType
+		mid  float32 // expected value at progress 0.5
+	}{
+		{"InOutCubic", EaseInOutCubic, 0.5},
+		{"OutBounce", EaseOutBounce, 0.765625},
+		{"OutElastic", EaseOutElastic, 1.015625},
+		{"OutBack", EaseOutBack, 1.0876975},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := applyEasing(0, tt.ease); math.Abs(float64(got)) > 1e-5 {
+				t.Errorf("applyEasing(0) = %f, want 0", got)
+			}
+			if got := applyEasing(1, tt.ease); math.Abs(float64(got-1)) > 1e-5 {
+				t.Errorf("applyEasing(1) = %f, want 1", got)
+			}
+			if got := applyEasing(0.5, tt.ease); math.Abs(float64(got-tt.mid)) > 1e-4 {
+				t.Errorf("applyEasing(0.5) = %f, want %f", got, tt.mid)
+			}
+		})
+	}
+}
+
+func TestApplyEasing_ExistingValuesStable(t *testing.T) {
+	if EaseLinear != 0 || EaseInOut != 1 || EaseIn != 2 || EaseOut != 3 {
+		t.Error("existing EaseType values must keep their numeric positions")
+	}
+}
+
+func TestAnimateScreenRotation_WritesBack(t *testing.T) {
+	screen := NewTextScreen(Vec3{}, 100, 100, 1.0)
+	am := NewAnimationManager()
+	am.AnimateScreenRotation(screen, Vec3{Y: 90}, 1.0)
+
+	prev := screen.Rotation.Y
+	for i := 0; i < 4; i++ {
+		am.Update(0.25)
+		if screen.Rotation.Y <= prev {
+			t.Errorf("tick %d: Rotation.Y = %f, want > %f", i, screen.Rotation.Y, prev)
+		}
+		prev = screen.Rotation.Y
+	}
+
+	if screen.Rotation.Y != 90 {
+		t.Errorf("final Rotation.Y = %f, want 90", screen.Rotation.Y)
+	}
+}
+
+func TestAnimateScreenPosition_WritesBack(t *testing.T) {
+	screen := NewTextScreen(Vec3{X: 10}, 100, 100, 1.0)
+	am := NewAnimationManager()
+	am.AnimateScreenPosition(screen, Vec3{X: 20, Y: 40}, 2.0)
+
+	am.Update(1.0)
+	want := Vec3{X: 15, Y: 20}
+	if screen.Position != want {
+		t.Errorf("Position = %v, want %v", screen.Position, want)
+	}
+}
+
+func TestAnimateFloat(t *testing.T) {
+	am := NewAnimationManager()
+	var got []float32
+	anim := am.AnimateFloat(func(v float32) { got = append(got, v) }, 1, 3, 1.0)
+	anim.EaseType = EaseIn
+
+	for i := 0; i < 4; i++ {
+		am.Update(0.25)
+	}
+
+	// EaseIn squares the progress: 1/16, 1/4, 9/16, 1 of the way to 3
+	want := []float32{1.125, 1.5, 2.125, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("setter received %v, want %v", got, want)
+	}
+	if !anim.Completed || len(am.Animations) != 0 {
+		t.Errorf("animation should be completed and removed, Completed = %v", anim.Completed)
+	}
+
+	if v := EvaluateTween(float32(1), float32(3), 1.0, 0.5, EaseIn); v != float32(1.5) {
+		t.Errorf("EvaluateTween(float32) = %v, want 1.5", v)
+	}
+}
+
+func TestAnimation_ColorSpace(t *testing.T) {
+	tests := []struct {
+		space ColorSpace
+		want  Color
+	}{
+		// Naive blending darkens the midpoint to olive
+		{ColorSpaceSRGB, Color{127, 127, 0, 255}},
+		// Half the light of each, re-encoded, is much brighter
+		{ColorSpaceLinear, Color{188, 188, 0, 255}},
+		// Halfway round the hue circle from red to green is yellow
+		{ColorSpaceHSV, Color{255, 255, 0, 255}},
+	}
+
+	for _, tt := range tests {
+		anim := &Animation{
+			Type:       AnimationTypeColor,
+			StartValue: ColorRed,
+			EndValue:   ColorGreen,
+			Duration:   1.0,
+			ColorSpace: tt.space,
+		}
+		anim.Update(0.5)
+		if anim.CurrentValue != tt.want {
+			t.Errorf("color space %d: midpoint = %v, want %v", tt.space, anim.CurrentValue, tt.want)
+		}
+		anim.Update(0.5)
+		if anim.CurrentValue != ColorGreen {
+			t.Errorf("color space %d: end = %v, want %v", tt.space, anim.CurrentValue, ColorGreen)
+		}
+	}
+
+	// Hue takes the short way round: magenta to red passes through pink
+	if got := lerpColorSpace(Color{255, 0, 255, 255}, ColorRed, 0.5, ColorSpaceHSV); got != (Color{255, 0, 128, 255}) {
+		t.Errorf("HSV magenta to red midpoint = %v, want {255 0 128 255}", got)
+	}
+}
+
+func TestSpringAnimation_Settles(t *testing.T) {
+	am := NewAnimationManager()
+	spring := NewSpringAnimation(Vec3{}, Vec3{X: 10, Y: -4}, 100)
+	var applied Vec3
+	spring.Apply = func(v Vec3) { applied = v }
+	am.AddSpring(spring)
+
+	// A critically damped spring approaches without overshooting
+	for i := 0; i < 300 && !spring.Settled(); i++ {
+		am.Update(1.0 / 60)
+		if spring.Value.X > 10+1e-3 {
+			t.Fatalf("frame %d: Value.X = %f overshot the target", i, spring.Value.X)
+		}
+	}
+	if !spring.Settled() {
+		t.Fatalf("spring did not settle, Value = %v, Velocity = %v", spring.Value, spring.Velocity)
+	}
+	if spring.Value != spring.Target || applied != spring.Target {
+		t.Errorf("settled Value = %v, applied %v, want %v", spring.Value, applied, spring.Target)
+	}
+
+	// Moving the target wakes it again
+	spring.SetTarget(Vec3{X: 20})
+	if spring.Settled() {
+		t.Error("spring still settled after SetTarget")
+	}
+	am.Update(1.0 / 60)
+	if spring.Value.X <= 10 || len(am.Springs) != 1 {
+		t.Errorf("after SetTarget, Value = %v with %d springs, want it moving toward X 20", spring.Value, len(am.Springs))
+	}
+}
@@ -0,0 +1,139 @@
+// Package core provides color space conversions for the Spectrex framework.
+package core
+
+import "math"
+
+// ColorSpace selects how colors are interpolated.
+type ColorSpace int
+
+const (
+	// ColorSpaceSRGB interpolates the stored 8-bit channels directly.
+	ColorSpaceSRGB ColorSpace = iota
+	// ColorSpaceLinear decodes the channels from sRGB to linear light,
+	// interpolates, then re-encodes, avoiding the dark, muddy midpoints of
+	// fades between saturated colors.
+	ColorSpaceLinear
+	// ColorSpaceHSV interpolates hue, saturation and value, turning the
+	// shorter way around the hue circle, so fades keep their saturation.
+	ColorSpaceHSV
+)
+
+// lerpColorSpace interpolates between two colors in the given color space.
+// Alpha is always interpolated directly.
+func lerpColorSpace(a, b Color, t float32, space ColorSpace) Color {
+	var c Color
+	switch space {
+	case ColorSpaceLinear:
+		c = Color{
+			R: srgbEncode(lerpFloat(srgbDecode(a.R), srgbDecode(b.R), t)),
+			G: srgbEncode(lerpFloat(srgbDecode(a.G), srgbDecode(b.G), t)),
+			B: srgbEncode(lerpFloat(srgbDecode(a.B), srgbDecode(b.B), t)),
+		}
+	case ColorSpaceHSV:
+		h1, s1, v1 := rgbToHSV(a)
+		h2, s2, v2 := rgbToHSV(b)
+		// Grays have no hue of their own; take the other color's
+		if s1 == 0 {
+			h1 = h2
+		} else if s2 == 0 {
+			h2 = h1
+		}
+		if h2-h1 > 180 {
+			h2 -= 360
+		} else if h1-h2 > 180 {
+			h2 += 360
+		}
+		c = hsvToRGB(lerpFloat(h1, h2, t), lerpFloat(s1, s2, t), lerpFloat(v1, v2, t))
+	default:
+		return lerpColor(a, b, t)
+	}
+	c.A = lerpChannel(a.A, b.A, t)
+	return c
+}
+
+// srgbDecode converts an sRGB channel to linear light in [0, 1].
+func srgbDecode(c uint8) float32 {
+	v := float64(c) / 255
+	if v <= 0.04045 {
+		return float32(v / 12.92)
+	}
+	return float32(math.Pow((v+0.055)/1.055, 2.4))
+}
+
+// srgbEncode converts linear light to an sRGB channel, clamping values
+// outside [0, 1].
+func srgbEncode(v float32) uint8 {
+	l := float64(clampf(v, 0, 1))
+	if l <= 0.0031308 {
+		l *= 12.92
+	} else {
+		l = 1.055*math.Pow(l, 1/2.4) - 0.055
+	}
+	return uint8(math.Round(l * 255))
+}
+
+// rgbToHSV returns a color's hue in degrees [0, 360) and its saturation
+// and value in [0, 1].
+func rgbToHSV(c Color) (h, s, v float32) {
+	r, g, b := float32(c.R)/255, float32(c.G)/255, float32(c.B)/255
+	hi := max(r, g, b)
+	lo := min(r, g, b)
+	delta := hi - lo
+
+	v = hi
+	if hi > 0 {
+		s = delta / hi
+	}
+	if delta == 0 {
+		return 0, s, v
+	}
+
+	switch hi {
+	case r:
+		h = 60 * (g - b) / delta
+	case g:
+		h = 60 * ((b-r)/delta + 2)
+	default:
+		h = 60 * ((r-g)/delta + 4)
+	}
+	if h < 0 {
+		h += 360
+	}
+	return h, s, v
+}
+
+// hsvToRGB converts a hue in degrees, which wraps, and a saturation and
+// value in [0, 1], which are clamped, to a color with zero alpha.
+func hsvToRGB(h, s, v float32) Color {
+	s = clampf(s, 0, 1)
+	v = clampf(v, 0, 1)
+	h = float32(math.Mod(float64(h), 360))
+	if h < 0 {
+		h += 360
+	}
+
+	chroma := v * s
+	x := chroma * (1 - float32(math.Abs(math.Mod(float64(h/60), 2)-1)))
+	m := v - chroma
+
+	var r, g, b float32
+	switch {
+	case h < 60:
+		r, g, b = chroma, x, 0
+	case h < 120:
+		r, g, b = x, chroma, 0
+	case h < 180:
+		r, g, b = 0, chroma, x
+	case h < 240:
+		r, g, b = 0, x, chroma
+	case h < 300:
+		r, g, b = x, 0, chroma
+	default:
+		r, g, b = chroma, 0, x
+	}
+
+	channel := func(c float32) uint8 {
+		return uint8(math.Round(float64((c + m) * 255)))
+	}
+	return Color{R: channel(r), G: channel(g), B: channel(b)}
+}
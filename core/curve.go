@@ -0,0 +1,71 @@
+// Package core provides curve sampling for the Spectrex framework. Curves
+// are returned as polylines, ready to draw with DrawLine3D.
+package core
+
+// Bezier3 samples the cubic Bézier curve from p0 to p3 with control points
+// p1 and p2 into segments+1 evenly spaced (in t) points. The first and last
+// points are exactly p0 and p3. segments is raised to at least 1.
+func Bezier3(p0, p1, p2, p3 Vec3, segments int) []Vec3 {
+	if segments < 1 {
+		segments = 1
+	}
+
+	points := make([]Vec3, segments+1)
+	for i := range points {
+		t := float32(i) / float32(segments)
+		u := 1 - t
+		points[i] = p0.Scale(u * u * u).
+			Add(p1.Scale(3 * u * u * t)).
+			Add(p2.Scale(3 * u * t * t)).
+			Add(p3.Scale(t * t * t))
+	}
+	points[0] = p0
+	points[segments] = p3
+	return points
+}
+
+// CatmullRom samples a uniform Catmull-Rom spline through points, with
+// segments lines between each consecutive pair, giving
+// (len(points)-1)*segments+1 points. The curve passes exactly through every
+// input point. The first and last spans are shaped by phantom points that
+// mirror their neighbors through the ends. Fewer than 2 points are returned as a copy. segments is raised to
+// at least 1.
+func CatmullRom(points []Vec3, segments int) []Vec3 {
+	if len(points) < 2 {
+		return append([]Vec3(nil), points...)
+	}
+	if segments < 1 {
+		segments = 1
+	}
+
+	curve := make([]Vec3, 0, (len(points)-1)*segments+1)
+	for span := 0; span < len(points)-1; span++ {
+		p1 := points[span]
+		p2 := points[span+1]
+		p0 := p1.Scale(2).Sub(p2)
+		if span > 0 {
+			p0 = points[span-1]
+		}
+		p3 := p2.Scale(2).Sub(p1)
+		if span+2 < len(points) {
+			p3 = points[span+2]
+		}
+
+		curve = append(curve, p1)
+		for i := 1; i < segments; i++ {
+			curve = append(curve, catmullRomPoint(p0, p1, p2, p3, float32(i)/float32(segments)))
+		}
+	}
+	return append(curve, points[len(points)-1])
+}
+
+// catmullRomPoint evaluates the uniform Catmull-Rom span from p1 to p2 at t.
+func catmullRomPoint(p0, p1, p2, p3 Vec3, t float32) Vec3 {
+	t2 := t * t
+	t3 := t2 * t
+	return p1.Scale(2).
+		Add(p2.Sub(p0).Scale(t)).
+		Add(p0.Scale(2).Sub(p1.Scale(5)).Add(p2.Scale(4)).Sub(p3).Scale(t2)).
+		Add(p1.Scale(3).Sub(p0).Sub(p2.Scale(3)).Add(p3).Scale(t3)).
+		Scale(0.5)
+}
@@ -0,0 +1,79 @@
+package core
+
+import "testing"
+
+func TestBezier3(t *testing.T) {
+	p0 := Vec3{X: 0, Y: 0, Z: 0}
+	p1 := Vec3{X: 0, Y: 10, Z: 0}
+	p2 := Vec3{X: 10, Y: 10, Z: 5}
+	p3 := Vec3{X: 10.3, Y: 0.7, Z: 5.1}
+
+	for _, segments := range []int{1, 2, 7, 16} {
+		points := Bezier3(p0, p1, p2, p3, segments)
+		if len(points) != segments+1 {
+			t.Fatalf("segments %d: got %d points, want %d", segments, len(points), segments+1)
+		}
+		if points[0] != p0 || points[segments] != p3 {
+			t.Errorf("segments %d: endpoints = %v, %v, want %v, %v", segments, points[0], points[segments], p0, p3)
+		}
+	}
+
+	// The midpoint of a cubic is (p0 + 3p1 + 3p2 + p3) / 8
+	mid := Bezier3(p0, p1, p2, p3, 2)[1]
+	want := p0.Add(p1.Scale(3)).Add(p2.Scale(3)).Add(p3).Scale(1.0 / 8)
+	if !vec3Near(mid, want) {
+		t.Errorf("midpoint = %v, want %v", mid, want)
+	}
+
+	// Collinear evenly spaced controls give a straight, evenly sampled line
+	line := Bezier3(Vec3{}, Vec3{X: 1}, Vec3{X: 2}, Vec3{X: 3}, 3)
+	for i, p := range line {
+		if !vec3Near(p, Vec3{X: float32(i)}) {
+			t.Errorf("straight line point %d = %v, want {%d 0 0}", i, p, i)
+		}
+	}
+
+	if got := len(Bezier3(p0, p1, p2, p3, 0)); got != 2 {
+		t.Errorf("segments 0: got %d points, want 2", got)
+	}
+}
+
+func TestCatmullRom(t *testing.T) {
+	points := []Vec3{
+		{X: 0, Y: 0},
+		{X: 10, Y: 5},
+		{X: 20, Y: -3, Z: 4},
+		{X: 25.5, Y: 1.1, Z: 0.3},
+	}
+
+	for _, segments := range []int{1, 4, 9} {
+		curve := CatmullRom(points, segments)
+		if want := (len(points)-1)*segments + 1; len(curve) != want {
+			t.Fatalf("segments %d: got %d points, want %d", segments, len(curve), want)
+		}
+		// Every control point is hit exactly
+		for i, p := range points {
+			if got := curve[i*segments]; got != p {
+				t.Errorf("segments %d: curve[%d] = %v, want control point %v", segments, i*segments, got, p)
+			}
+		}
+	}
+
+	// Two points give segments+1 points along the straight line between them
+	two := CatmullRom([]Vec3{{}, {X: 4}}, 4)
+	if len(two) != 5 {
+		t.Fatalf("two points: got %d points, want 5", len(two))
+	}
+	for i, p := range two {
+		if !vec3Near(p, Vec3{X: float32(i)}) {
+			t.Errorf("two points: point %d = %v, want {%d 0 0}", i, p, i)
+		}
+	}
+
+	if got := CatmullRom([]Vec3{{X: 1}}, 5); len(got) != 1 || got[0] != (Vec3{X: 1}) {
+		t.Errorf("one point = %v, want it unchanged", got)
+	}
+	if got := CatmullRom(nil, 5); len(got) != 0 {
+		t.Errorf("no points = %v, want empty", got)
+	}
+}
@@ -8,10 +8,18 @@ type DisplayConfig struct {
 	WindowHeight int32
 	Title        string
 	Maximized    bool
+	Fullscreen   bool // Exclusive fullscreen at the monitor's resolution
+	Borderless   bool // Borderless window covering the monitor
 	Resizable    bool
 	VSync        bool
 	TargetFPS    int32
 
+	// MSAASamples requests multisampled antialiasing: 0 for none, or 2, 4
+	// or 8 samples. raylib only offers a 4x hint, so any nonzero value gets
+	// 4x where the driver supports it. Render textures are not
+	// multisampled, so it only affects drawing straight to the window.
+	MSAASamples int32
+
 	// Render settings - if different from window size, rendering is done
 	// to a texture and upscaled/downscaled to fit the window
 	RenderWidth  int32
@@ -28,9 +36,12 @@ func DefaultDisplayConfig() DisplayConfig {
 		WindowHeight: 720,
 		Title:        "Spectrex",
 		Maximized:    false,
+		Fullscreen:   false,
+		Borderless:   false,
 		Resizable:    true,
 		VSync:        true,
 		TargetFPS:    60,
+		MSAASamples:  0,
 		RenderWidth:  0, // 0 means use window size
 		RenderHeight: 0,
 		DefaultFOV:   45.0,
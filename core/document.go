@@ -3,9 +3,15 @@
 package core
 
 import (
+	"fmt"
+	"slices"
 	"strings"
+	"unicode/utf8"
 )
 
+// ListBullet is the marker drawn before unordered list items.
+const ListBullet = "\u2022"
+
 // TextStyle defines a set of styling properties for text rendering.
 type TextStyle struct {
 	Font        *HersheyFont
@@ -13,9 +19,20 @@ type TextStyle struct {
 	Scale       float32
 	LineSpacing float32
 	CharSpacing float32
+	WordSpacing float32
 	HAlign      TextAlign
 	VAlign      VerticalAlign
 	WordWrap    bool
+
+	Underline     bool // Draw a line along each line's baseline
+	Strikethrough bool // Draw a line through the middle of each line
+	Bold          bool // Thicken glyphs by drawing each stroke several times
+	RoundCaps     bool // Round off thick glyph strokes; see TextRegion.RoundCaps
+
+	// Dashed draws glyph strokes as dashes; see TextRegion.Dashed.
+	Dashed     bool
+	DashLength float32
+	DashGap    float32
 }
 
 // TextDocument represents a complex text document with multiple regions
@@ -29,13 +46,56 @@ type TextDocument struct {
 }
 
 // TextSection represents a section of content within a document.
+// When Items is non-empty the section renders as a list and Content is ignored.
 type TextSection struct {
 	Title      string
 	Content    string
+	Items      []string
+	Ordered    bool
 	Style      TextStyle
 	TitleStyle TextStyle
 	Region     *TextRegion
 	Document   *TextDocument
+
+	// Title and content regions built by SubRegions, and what they were
+	// built from
+	titleRegion   *TextRegion
+	contentRegion *TextRegion
+	subRegionsKey subRegionsKey
+
+	// List item and marker regions built by ListRegions, and what they
+	// were built from
+	itemRegions   []*TextRegion
+	markerRegions []*TextRegion
+	listKey       listRegionsKey
+	listItems     []string
+}
+
+// subRegionsKey holds everything a titled section's sub-regions are built
+// and measured from, so they are rebuilt only when it changes.
+type subRegionsKey struct {
+	title, content         string
+	style, titleStyle      TextStyle
+	titleFont, contentFont *HersheyFont
+
+	parent              *TextScreen
+	parentScale         float32
+	x, y, width, height float32
+	padding, tabWidth   float32
+	breakLongWords      bool
+	direction           TextDirection
+	singleLine          bool
+	ellipsis            EllipsisMode
+	overflowMarker      string
+}
+
+// listRegionsKey holds everything a list section's item regions are built
+// from besides the items themselves.
+type listRegionsKey struct {
+	subRegionsKey
+	ordered     bool
+	screenScale float32
+	top         float32
 }
 
 // NewTextDocument creates a new text document with the specified screen.
@@ -92,30 +152,30 @@ func (doc *TextDocument) Layout() {
 	currentY := doc.Screen.Height - doc.Padding
 
 	for _, section := range doc.Sections {
-		contentLinesCount := len(strings.Split(section.Content, "\n"))
-		contentLines := float32(contentLinesCount)
-
-		if section.Style.WordWrap {
-			avgCharsPerLine := columnWidth / (section.Style.Scale * 8)
-			totalChars := float32(len(section.Content))
-			estimatedLines := int(totalChars / avgCharsPerLine)
-			if estimatedLines > contentLinesCount {
-				contentLines = float32(estimatedLines)
-			}
-		}
+		// Build the region first so wrapping can be measured with the
+		// section's real font, style and column width
+		region := doc.Screen.AddRegion(0, 0, columnWidth, 0)
 
-		titleHeight := float32(0)
-		if section.Title != "" && doc.PageStyle.Font != nil {
-			titleLines := float32(len(strings.Split(section.Title, "\n")))
-			titleHeight = titleLines * float32(doc.PageStyle.Font.Height) *
-				section.TitleStyle.Scale * section.TitleStyle.LineSpacing
-			titleHeight += float32(doc.PageStyle.Font.Height) * section.Style.Scale * 0.5
-		}
+		region.Scale = section.Style.Scale
+		region.LineSpacing = section.Style.LineSpacing
+		region.CharSpacing = section.Style.CharSpacing
+		region.WordSpacing = section.Style.WordSpacing
+		region.HAlign = section.Style.HAlign
+		region.VAlign = section.Style.VAlign
+		region.WordWrap = section.Style.WordWrap
+		region.Underline = section.Style.Underline
+		region.Strikethrough = section.Style.Strikethrough
+		region.Bold = section.Style.Bold
+		region.RoundCaps = section.Style.RoundCaps
+		region.Dashed = section.Style.Dashed
+		region.DashLength = section.Style.DashLength
+		region.DashGap = section.Style.DashGap
 
-		sectionHeight := titleHeight
-		if doc.PageStyle.Font != nil {
-			sectionHeight += contentLines * float32(doc.PageStyle.Font.Height) *
-				section.Style.Scale * section.Style.LineSpacing
+		section.Region = region
+
+		sectionHeight := section.TitleHeight() + section.ContentHeight()
+		if section.Title != "" {
+			sectionHeight += section.TitleGap()
 		}
 
 		// Check if we need to move to next column (Y going below padding)
@@ -128,20 +188,10 @@ func (doc *TextDocument) Layout() {
 			}
 		}
 
-		x := doc.Padding + float32(currentColumn)*columnWidth
 		// Region Y is at bottom of section, height extends upward
-		y := currentY - sectionHeight
-
-		region := doc.Screen.AddRegion(x, y, columnWidth, sectionHeight)
-
-		region.Scale = section.Style.Scale
-		region.LineSpacing = section.Style.LineSpacing
-		region.CharSpacing = section.Style.CharSpacing
-		region.HAlign = section.Style.HAlign
-		region.VAlign = section.Style.VAlign
-		region.WordWrap = section.Style.WordWrap
-
-		section.Region = region
+		region.X = doc.Padding + float32(currentColumn)*columnWidth
+		region.Y = currentY - sectionHeight
+		region.Height = sectionHeight
 
 		// Move down for next section (decrease Y)
 		if doc.PageStyle.Font != nil {
@@ -152,6 +202,363 @@ func (doc *TextDocument) Layout() {
 	}
 }
 
+// TitleHeight returns the height of the section's title block, using the
+// wrapped line count at the width of the section's region.
+// Returns 0 if the section has no title, region or title font.
+func (section *TextSection) TitleHeight() float32 {
+	font := section.GetTitleFont()
+	if section.Title == "" || section.Region == nil || font == nil {
+		return 0
+	}
+
+	lines := section.measureLines(section.Title, font, section.TitleStyle, true, section.Region.Width)
+	return float32(lines) * float32(font.Height) *
+		section.TitleStyle.Scale * section.TitleStyle.LineSpacing
+}
+
+// TitleGap returns the vertical space between the title and the content.
+func (section *TextSection) TitleGap() float32 {
+	font := section.GetTitleFont()
+	if font == nil {
+		return 0
+	}
+	return float32(font.Height) * section.Style.Scale * 0.5
+}
+
+// ContentHeight returns the height of the section's content, using the
+// wrapped line count at the width of the section's region.
+// Returns 0 if the section has no region or content font.
+func (section *TextSection) ContentHeight() float32 {
+	font := section.GetContentFont()
+	if section.Region == nil || font == nil {
+		return 0
+	}
+
+	if section.IsList() {
+		var height float32
+		for _, itemHeight := range section.ListItemHeights() {
+			height += itemHeight
+		}
+		return height
+	}
+
+	// Titled sections render content in a separate, always-wrapped region
+	wrap := section.Style.WordWrap || section.Title != ""
+	lines := section.measureLines(section.Content, font, section.Style, wrap, section.Region.Width)
+	return float32(lines) * float32(font.Height) *
+		section.Style.Scale * section.Style.LineSpacing
+}
+
+// SubRegions returns the regions a titled section is drawn in: the title
+// across the top of the section's region and the content below it,
+// TitleGap lower. They are kept between calls and rebuilt only when the
+// section's text, styles or region change, so renderers can draw them
+// every frame without allocating. Returns nil regions if the section has
+// no title, region or title font.
+func (section *TextSection) SubRegions() (title, content *TextRegion) {
+	titleFont := section.GetTitleFont()
+	region := section.Region
+	if section.Title == "" || region == nil || titleFont == nil {
+		return nil, nil
+	}
+
+	key := section.regionsKey()
+	if section.titleRegion != nil && key == section.subRegionsKey {
+		return section.titleRegion, section.contentRegion
+	}
+
+	titleHeight := section.TitleHeight()
+	section.titleRegion = section.styledRegion(section.TitleStyle, titleFont, section.Title)
+	section.titleRegion.Y = region.Y + region.Height - titleHeight
+	section.titleRegion.Height = titleHeight
+
+	section.contentRegion = section.styledRegion(section.Style, key.contentFont, section.Content)
+	section.contentRegion.Y = region.Y
+	section.contentRegion.Height = region.Height - titleHeight - section.TitleGap()
+
+	section.subRegionsKey = key
+	return section.titleRegion, section.contentRegion
+}
+
+// regionsKey returns what the section's sub-regions are built from.
+func (section *TextSection) regionsKey() subRegionsKey {
+	region := section.Region
+	key := subRegionsKey{
+		title:          section.Title,
+		content:        section.Content,
+		style:          section.Style,
+		titleStyle:     section.TitleStyle,
+		titleFont:      section.GetTitleFont(),
+		contentFont:    section.GetContentFont(),
+		parent:         region.Parent,
+		x:              region.X,
+		y:              region.Y,
+		width:          region.Width,
+		height:         region.Height,
+		padding:        region.Padding,
+		tabWidth:       region.TabWidth,
+		breakLongWords: region.BreakLongWords,
+		direction:      region.Direction,
+		singleLine:     region.SingleLine,
+		ellipsis:       region.Ellipsis,
+		overflowMarker: region.OverflowMarker,
+	}
+	if region.Parent != nil {
+		key.parentScale = region.Parent.Scale
+	}
+	return key
+}
+
+// styledRegion returns a wrapped region spanning the width of the
+// section's region, showing text in the given style and font.
+func (section *TextSection) styledRegion(style TextStyle, font *HersheyFont, text string) *TextRegion {
+	return &TextRegion{
+		X:           section.Region.X,
+		Width:       section.Region.Width,
+		Text:        text,
+		Font:        font,
+		Color:       style.Color,
+		Scale:       style.Scale,
+		LineSpacing: style.LineSpacing,
+		CharSpacing: style.CharSpacing,
+		WordSpacing: style.WordSpacing,
+		HAlign:      style.HAlign,
+		VAlign:      style.VAlign,
+		WordWrap:    true,
+		Parent:      section.Region.Parent,
+
+		// Layout settings that change how text wraps, shared with wrapLines
+		// so text is drawn on the lines it was measured at
+		Padding:        section.Region.Padding,
+		TabWidth:       section.Region.TabWidth,
+		BreakLongWords: section.Region.BreakLongWords,
+		Direction:      section.Region.Direction,
+		SingleLine:     section.Region.SingleLine,
+		Ellipsis:       section.Region.Ellipsis,
+		OverflowMarker: section.Region.OverflowMarker,
+
+		Underline:     style.Underline,
+		Strikethrough: style.Strikethrough,
+		Bold:          style.Bold,
+		RoundCaps:     style.RoundCaps,
+
+		Dashed:     style.Dashed,
+		DashLength: style.DashLength,
+		DashGap:    style.DashGap,
+	}
+}
+
+// SetList turns the section into a list of items. Ordered lists are
+// numbered "1.", "2.", ...; unordered lists use ListBullet.
+func (section *TextSection) SetList(items []string, ordered bool) {
+	section.Items = items
+	section.Ordered = ordered
+}
+
+// IsList returns whether the section renders as a list.
+func (section *TextSection) IsList() bool {
+	return len(section.Items) > 0
+}
+
+// ListMarker returns the marker for the item at index.
+func (section *TextSection) ListMarker(index int) string {
+	if section.Ordered {
+		return fmt.Sprintf("%d.", index+1)
+	}
+	return ListBullet
+}
+
+// ListIndent returns the hanging indent for list items: the width of the
+// widest marker plus a space, so wrapped lines align past the markers.
+// Returns 0 if the section is not a list or has no content font.
+func (section *TextSection) ListIndent() float32 {
+	font := section.GetContentFont()
+	if !section.IsList() || font == nil {
+		return 0
+	}
+
+	measure := TextRegion{Font: font, CharSpacing: section.Style.CharSpacing, WordSpacing: section.Style.WordSpacing}
+	scale := section.Style.Scale
+	if section.Document != nil && section.Document.Screen != nil {
+		scale *= section.Document.Screen.Scale
+	}
+
+	var widest float32
+	for i := range section.Items {
+		marker := section.ListMarker(i)
+		if marker == ListBullet {
+			// Hershey fonts have no bullet glyph; renderers draw a dot
+			// the size of an "o" in its place
+			marker = "o"
+		}
+		if w := measure.CalculateLineWidth(marker, scale); w > widest {
+			widest = w
+		}
+	}
+
+	return widest + measure.SpaceAdvance(scale)
+}
+
+// ListItemHeights returns the height of each list item when wrapped to
+// the section's region width less the hanging indent.
+func (section *TextSection) ListItemHeights() []float32 {
+	font := section.GetContentFont()
+	if !section.IsList() || section.Region == nil || font == nil {
+		return nil
+	}
+
+	width := section.Region.Width - section.ListIndent()
+	lineHeight := float32(font.Height) * section.Style.Scale * section.Style.LineSpacing
+
+	heights := make([]float32, len(section.Items))
+	for i, item := range section.Items {
+		heights[i] = float32(section.measureLines(item, font, section.Style, true, width)) * lineHeight
+	}
+	return heights
+}
+
+// ListRegions returns the regions a list section's items are drawn in,
+// stacked downward from top: each item's wrapped text at the hanging
+// indent, and its marker in the column before it. Markers sit at the
+// visual left, or the right for DirectionRightToLeft. The regions are
+// kept and reused until the section's items, styles, region or top
+// change. Returns nil if the section is not a list or has not been laid
+// out.
+func (section *TextSection) ListRegions(top float32) (items, markers []*TextRegion) {
+	font := section.GetContentFont()
+	if !section.IsList() || section.Region == nil || font == nil {
+		return nil, nil
+	}
+
+	key := listRegionsKey{subRegionsKey: section.regionsKey(), ordered: section.Ordered, top: top}
+	if section.Document != nil && section.Document.Screen != nil {
+		key.screenScale = section.Document.Screen.Scale
+	}
+	if section.itemRegions != nil && key == section.listKey && slices.Equal(section.Items, section.listItems) {
+		return section.itemRegions, section.markerRegions
+	}
+
+	region := section.Region
+	indent := section.ListIndent()
+	heights := section.ListItemHeights()
+	rtl := region.Direction == DirectionRightToLeft
+
+	items = make([]*TextRegion, len(section.Items))
+	markers = make([]*TextRegion, len(section.Items))
+	y := top
+	for i, item := range section.Items {
+		y -= heights[i]
+
+		// Visual left is at X+Width, so left-to-right items keep X and
+		// give up the marker column at their far end
+		itemRegion := section.styledRegion(section.Style, font, item)
+		itemRegion.Y = y
+		itemRegion.Height = heights[i]
+		itemRegion.Width = region.Width - indent
+		itemRegion.HAlign = AlignLeft
+		itemRegion.VAlign = AlignTop
+
+		marker := section.styledRegion(section.Style, font, section.ListMarker(i))
+		marker.Y = y
+		marker.Height = heights[i]
+		marker.Width = indent
+		marker.HAlign = AlignLeft
+		marker.VAlign = AlignTop
+		marker.WordWrap = false
+		marker.Underline = false
+		marker.Strikethrough = false
+		if rtl {
+			itemRegion.X = region.X + indent
+			itemRegion.HAlign = AlignRight
+			marker.HAlign = AlignRight
+		} else {
+			marker.X = region.X + region.Width - indent
+		}
+
+		items[i], markers[i] = itemRegion, marker
+	}
+
+	section.itemRegions, section.markerRegions = items, markers
+	section.listKey = key
+	section.listItems = slices.Clone(section.Items)
+	return items, markers
+}
+
+// measureLines returns how many lines text occupies when laid out in the
+// section's region at the given width with the given font and style.
+func (section *TextSection) measureLines(text string, font *HersheyFont, style TextStyle, wrap bool, width float32) int {
+	return len(section.wrapLines(text, font, style, wrap, width))
+}
+
+// wrapLines returns text broken into the lines it occupies when laid out
+// in the section's region at the given width with the given font and style.
+func (section *TextSection) wrapLines(text string, font *HersheyFont, style TextStyle, wrap bool, width float32) []string {
+	measure := section.styledRegion(style, font, text)
+	measure.Width = width
+	measure.WordWrap = wrap
+	return measure.GetLines()
+}
+
+// ToPlainText returns the document's text in reading order: each section's
+// title followed by its content, with sections separated by a blank line.
+// After Layout, lines are wrapped as they are rendered; before it, text is
+// split on its own newlines only.
+func (doc *TextDocument) ToPlainText() string {
+	var blocks []string
+	for _, section := range doc.Sections {
+		var lines []string
+		if section.Title != "" {
+			lines = append(lines, section.titleLines()...)
+		}
+		lines = append(lines, section.contentLines()...)
+		blocks = append(blocks, strings.Join(lines, "\n"))
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// titleLines returns the title's lines as laid out, or split on newlines
+// if the section has not been laid out.
+func (section *TextSection) titleLines() []string {
+	font := section.GetTitleFont()
+	if section.Region == nil || font == nil {
+		return strings.Split(section.Title, "\n")
+	}
+	return section.wrapLines(section.Title, font, section.TitleStyle, true, section.Region.Width)
+}
+
+// contentLines returns the content's lines as laid out, or split on
+// newlines if the section has not been laid out. List items are prefixed
+// with their markers and continuation lines indented to match.
+func (section *TextSection) contentLines() []string {
+	font := section.GetContentFont()
+	laidOut := section.Region != nil && font != nil
+
+	if !section.IsList() {
+		if !laidOut {
+			return strings.Split(section.Content, "\n")
+		}
+		wrap := section.Style.WordWrap || section.Title != ""
+		return section.wrapLines(section.Content, font, section.Style, wrap, section.Region.Width)
+	}
+
+	var lines []string
+	for i, item := range section.Items {
+		marker := section.ListMarker(i) + " "
+		itemLines := strings.Split(item, "\n")
+		if laidOut {
+			itemLines = section.wrapLines(item, font, section.Style, true, section.Region.Width-section.ListIndent())
+		}
+		for j, line := range itemLines {
+			if j == 0 {
+				lines = append(lines, marker+line)
+			} else {
+				lines = append(lines, strings.Repeat(" ", utf8.RuneCountInString(marker))+line)
+			}
+		}
+	}
+	return lines
+}
+
 // SetStyle sets the style for a section.
 func (section *TextSection) SetStyle(style TextStyle) {
 	section.Style = style
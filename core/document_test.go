@@ -0,0 +1,310 @@
+package core
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestTextDocument_LayoutUsesWrappedLineCount(t *testing.T) {
+	font := newTestFont()
+	screen := NewTextScreen(Vec3{}, 240, 2000, 1.0)
+	doc := NewTextDocument(screen, 1, 20)
+	doc.PageStyle.Font = font
+
+	// 200 units of column width fits 18 glyphs per line
+	content := strings.Repeat("word ", 40)
+	section := doc.AddSection("", content)
+	doc.Layout()
+
+	region := section.Region
+	if region == nil {
+		t.Fatal("Layout did not assign a region")
+	}
+
+	region.Text = content
+	region.Font = font
+	lines := len(region.GetLines())
+	if lines < 2 {
+		t.Fatalf("content wrapped to %d lines, want several", lines)
+	}
+
+	want := float32(lines) * float32(font.Height) * section.Style.Scale * section.Style.LineSpacing
+	if region.Height != want {
+		t.Errorf("section height = %f, want %f for %d wrapped lines", region.Height, want, lines)
+	}
+}
+
+func TestTextDocument_LayoutTitledSection(t *testing.T) {
+	font := newTestFont()
+	screen := NewTextScreen(Vec3{}, 240, 2000, 1.0)
+	doc := NewTextDocument(screen, 1, 20)
+	doc.PageStyle.Font = font
+
+	section := doc.AddSection("Title", "one\ntwo\nthree")
+	doc.Layout()
+
+	titleHeight := float32(font.Height) * section.TitleStyle.Scale * section.TitleStyle.LineSpacing
+	contentHeight := 3 * float32(font.Height) * section.Style.Scale * section.Style.LineSpacing
+	want := titleHeight + section.TitleGap() + contentHeight
+
+	if section.TitleHeight() != titleHeight {
+		t.Errorf("TitleHeight() = %f, want %f", section.TitleHeight(), titleHeight)
+	}
+	if section.Region.Height != want {
+		t.Errorf("section height = %f, want %f", section.Region.Height, want)
+	}
+}
+
+func TestTextSection_SubRegions(t *testing.T) {
+	screen := NewTextScreen(Vec3{}, 240, 2000, 1.0)
+	doc := NewTextDocument(screen, 1, 20)
+	doc.PageStyle.Font = newTestFont()
+	untitled := doc.AddSection("", "no title")
+	section := doc.AddSection("Title", strings.Repeat("word ", 40))
+	doc.Layout()
+
+	if title, content := untitled.SubRegions(); title != nil || content != nil {
+		t.Error("untitled section has sub-regions")
+	}
+
+	region := section.Region
+	title, content := section.SubRegions()
+	if title == nil || content == nil {
+		t.Fatal("titled section has no sub-regions")
+	}
+	if top := title.Y + title.Height; top != region.Y+region.Height {
+		t.Errorf("title top = %g, want the region top %g", top, region.Y+region.Height)
+	}
+	if content.Y != region.Y || content.Height != region.Height-section.TitleHeight()-section.TitleGap() {
+		t.Errorf("content spans %g+%g, want from the region bottom to the title gap", content.Y, content.Height)
+	}
+
+	// Unchanged sections reuse their regions
+	if again, _ := section.SubRegions(); again != title {
+		t.Error("SubRegions rebuilt an unchanged section")
+	}
+
+	section.Content = "changed"
+	if again, content := section.SubRegions(); again == title || content.Text != "changed" {
+		t.Error("SubRegions kept regions after the content changed")
+	}
+	title, _ = section.SubRegions()
+	section.TitleStyle.Color = ColorRed
+	if again, _ := section.SubRegions(); again == title || again.Color != ColorRed {
+		t.Error("SubRegions kept regions after the title style changed")
+	}
+}
+
+func TestTextSection_SubRegionsWrapAsMeasured(t *testing.T) {
+	screen := NewTextScreen(Vec3{}, 240, 2000, 1.0)
+	doc := NewTextDocument(screen, 1, 20)
+	doc.PageStyle.Font = newTestFont()
+	section := doc.AddSection("Title", strings.Repeat("a\tb ", 12)+strings.Repeat("x", 30))
+	doc.Layout()
+	section.Region.SetPadding(15)
+	section.Region.BreakLongWords = true
+
+	// Tabs, padding and broken words change the wrapping, so the content
+	// region must use them to take the lines ContentHeight measured
+	_, content := section.SubRegions()
+	lineHeight := float32(section.GetContentFont().Height) * section.Style.Scale * section.Style.LineSpacing
+	measured := int(math.Round(float64(section.ContentHeight() / lineHeight)))
+	if lines := len(content.GetLines()); lines != measured {
+		t.Errorf("content region wraps to %d lines, ContentHeight measured %d", lines, measured)
+	}
+	if content.TabWidth != section.Region.TabWidth || content.Padding != 15 || !content.BreakLongWords {
+		t.Errorf("content region has tab width %g, padding %g and BreakLongWords %v, want the section region's",
+			content.TabWidth, content.Padding, content.BreakLongWords)
+	}
+}
+
+func TestTextDocument_LayoutFlowsToNextColumn(t *testing.T) {
+	font := newTestFont()
+	screen := NewTextScreen(Vec3{}, 440, 200, 1.0)
+	doc := NewTextDocument(screen, 2, 20)
+	doc.PageStyle.Font = font
+
+	first := doc.AddSection("", "a\nb\nc")
+	second := doc.AddSection("", "d\ne\nf")
+	doc.Layout()
+
+	if first.Region.X != 20 {
+		t.Errorf("first section X = %f, want 20", first.Region.X)
+	}
+	if second.Region.X != 220 {
+		t.Errorf("second section X = %f, want 220 (second column)", second.Region.X)
+	}
+	if second.Region.Y+second.Region.Height != screen.Height-doc.Padding {
+		t.Errorf("second section top = %f, want %f", second.Region.Y+second.Region.Height, screen.Height-doc.Padding)
+	}
+}
+
+func TestTextSection_ListMarkers(t *testing.T) {
+	doc := NewTextDocument(NewTextScreen(Vec3{}, 240, 2000, 1.0), 1, 20)
+	section := doc.AddSection("", "")
+
+	section.SetList([]string{"a", "b", "c"}, true)
+	for i, want := range []string{"1.", "2.", "3."} {
+		if got := section.ListMarker(i); got != want {
+			t.Errorf("ordered ListMarker(%d) = %q, want %q", i, got, want)
+		}
+	}
+
+	section.SetList([]string{"a", "b"}, false)
+	if got := section.ListMarker(1); got != ListBullet {
+		t.Errorf("unordered ListMarker(1) = %q, want %q", got, ListBullet)
+	}
+}
+
+func TestTextSection_ListIndent(t *testing.T) {
+	doc := NewTextDocument(NewTextScreen(Vec3{}, 240, 2000, 1.0), 1, 20)
+	doc.PageStyle.Font = newTestFont()
+
+	tests := []struct {
+		name    string
+		items   int
+		ordered bool
+		want    float32
+	}{
+		// Widest marker plus a space, every glyph advancing 11
+		{"bullet", 3, false, 22},
+		{"single digit", 9, true, 33},
+		{"double digit", 10, true, 44},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			section := doc.AddSection("", "")
+			section.SetList(make([]string, tt.items), tt.ordered)
+			if got := section.ListIndent(); got != tt.want {
+				t.Errorf("ListIndent() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+
+	if got := doc.AddSection("", "text").ListIndent(); got != 0 {
+		t.Errorf("ListIndent() without items = %f, want 0", got)
+	}
+}
+
+func TestTextSection_ListHeightUsesHangingIndent(t *testing.T) {
+	font := newTestFont()
+	// Column width 200: 18 glyphs fit, 16 after the 22 unit bullet indent
+	doc := NewTextDocument(NewTextScreen(Vec3{}, 240, 2000, 1.0), 1, 20)
+	doc.PageStyle.Font = font
+
+	section := doc.AddSection("", "")
+	section.SetList([]string{"short", "aaaaaaaa bbbbbbbb"}, false)
+	doc.Layout()
+
+	lineHeight := float32(font.Height) * section.Style.Scale * section.Style.LineSpacing
+	heights := section.ListItemHeights()
+	if len(heights) != 2 {
+		t.Fatalf("ListItemHeights() returned %d heights, want 2", len(heights))
+	}
+	if heights[0] != lineHeight {
+		t.Errorf("short item height = %f, want %f", heights[0], lineHeight)
+	}
+	// 17 glyphs fit the full width but not the indented width
+	if heights[1] != 2*lineHeight {
+		t.Errorf("wrapped item height = %f, want %f", heights[1], 2*lineHeight)
+	}
+	if section.Region.Height != 3*lineHeight {
+		t.Errorf("section height = %f, want %f", section.Region.Height, 3*lineHeight)
+	}
+}
+
+func TestTextSection_ListRegions(t *testing.T) {
+	font := newTestFont()
+	doc := NewTextDocument(NewTextScreen(Vec3{}, 240, 2000, 1.0), 1, 20)
+	doc.PageStyle.Font = font
+	section := doc.AddSection("", "")
+	section.SetList([]string{"short", "aaaaaaaa bbbbbbbb", strings.Repeat("x", 30)}, true)
+	doc.Layout()
+	region := section.Region
+	region.SetPadding(10)
+	region.BreakLongWords = true
+
+	// Items are drawn on the lines they were measured at, padding and
+	// broken words included
+	top := region.Y + region.Height
+	items, markers := section.ListRegions(top)
+	if len(items) != 3 || len(markers) != 3 {
+		t.Fatalf("ListRegions returned %d items and %d markers, want 3", len(items), len(markers))
+	}
+	lineHeight := float32(font.Height) * section.Style.Scale * section.Style.LineSpacing
+	for i, item := range items {
+		if lines := len(item.GetLines()); float32(lines)*lineHeight != item.Height {
+			t.Errorf("item %d wraps to %d lines in a region %g tall", i, lines, item.Height)
+		}
+		if item.Padding != 10 || !item.BreakLongWords {
+			t.Errorf("item %d has padding %g and BreakLongWords %v, want the section region's", i, item.Padding, item.BreakLongWords)
+		}
+	}
+	if items[0].Y+items[0].Height != top || items[1].Y+items[1].Height != items[0].Y {
+		t.Error("items are not stacked downward from top")
+	}
+
+	// Markers take the column at the visual left, at the high X end, or
+	// the low X end for right-to-left text
+	indent := section.ListIndent()
+	if markers[1].Text != "2." || markers[1].X != region.X+region.Width-indent || items[1].X != region.X {
+		t.Errorf("marker %q at x %g and item at x %g, want the marker past the item", markers[1].Text, markers[1].X, items[1].X)
+	}
+	region.Direction = DirectionRightToLeft
+	items, markers = section.ListRegions(top)
+	if markers[1].X != region.X || items[1].X != region.X+indent || items[1].Direction != DirectionRightToLeft {
+		t.Errorf("right-to-left marker at x %g and item at x %g, want the marker before the item", markers[1].X, items[1].X)
+	}
+
+	// Unchanged lists reuse their regions
+	if again, _ := section.ListRegions(top); again[0] != items[0] {
+		t.Error("ListRegions rebuilt an unchanged list")
+	}
+	section.Items[0] = "changed"
+	if again, _ := section.ListRegions(top); again[0] == items[0] || again[0].Text != "changed" {
+		t.Error("ListRegions kept regions after an item changed")
+	}
+}
+
+func TestTextDocument_ToPlainText(t *testing.T) {
+	newDoc := func() *TextDocument {
+		doc := NewTextDocument(NewTextScreen(Vec3{}, 240, 2000, 1.0), 1, 20)
+		doc.PageStyle.Font = newTestFont()
+		doc.AddSection("First", "alpha beta gamma delta epsilon")
+		doc.AddSection("Second", "one\ntwo")
+		return doc
+	}
+
+	t.Run("before layout", func(t *testing.T) {
+		got := newDoc().ToPlainText()
+		want := "First\nalpha beta gamma delta epsilon\n\nSecond\none\ntwo"
+		if got != want {
+			t.Errorf("ToPlainText() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("after layout", func(t *testing.T) {
+		doc := newDoc()
+		doc.Layout()
+
+		// Column width 200 fits 18 glyphs per line
+		got := doc.ToPlainText()
+		want := "First\nalpha beta gamma\ndelta epsilon\n\nSecond\none\ntwo"
+		if got != want {
+			t.Errorf("ToPlainText() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		doc := NewTextDocument(NewTextScreen(Vec3{}, 240, 2000, 1.0), 1, 20)
+		doc.AddSection("Steps", "").SetList([]string{"first", "second"}, true)
+
+		got := doc.ToPlainText()
+		want := "Steps\n1. first\n2. second"
+		if got != want {
+			t.Errorf("ToPlainText() = %q, want %q", got, want)
+		}
+	})
+}
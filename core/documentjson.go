@@ -0,0 +1,179 @@
+// Package core provides JSON serialization for text documents.
+// Fonts are stored by name and resolved through DefaultFontRegistry on load.
+package core
+
+import (
+	"encoding/json"
+)
+
+// textStyleJSON is the serialized form of TextStyle.
+type textStyleJSON struct {
+	Font        string        `json:"font,omitempty"`
+	Color       Color         `json:"color"`
+	Scale       float32       `json:"scale"`
+	LineSpacing float32       `json:"lineSpacing"`
+	CharSpacing float32       `json:"charSpacing"`
+	WordSpacing float32       `json:"wordSpacing,omitempty"`
+	HAlign      TextAlign     `json:"hAlign"`
+	VAlign      VerticalAlign `json:"vAlign"`
+	WordWrap    bool          `json:"wordWrap"`
+
+	Underline     bool `json:"underline,omitempty"`
+	Strikethrough bool `json:"strikethrough,omitempty"`
+	Bold          bool `json:"bold,omitempty"`
+	RoundCaps     bool `json:"roundCaps,omitempty"`
+
+	Dashed     bool    `json:"dashed,omitempty"`
+	DashLength float32 `json:"dashLength,omitempty"`
+	DashGap    float32 `json:"dashGap,omitempty"`
+}
+
+// textSectionJSON is the serialized form of TextSection.
+type textSectionJSON struct {
+	Title      string    `json:"title,omitempty"`
+	Content    string    `json:"content"`
+	Items      []string  `json:"items,omitempty"`
+	Ordered    bool      `json:"ordered,omitempty"`
+	Style      TextStyle `json:"style"`
+	TitleStyle TextStyle `json:"titleStyle"`
+}
+
+// textDocumentJSON is the serialized form of TextDocument.
+type textDocumentJSON struct {
+	Columns   int            `json:"columns"`
+	Padding   float32        `json:"padding"`
+	PageStyle TextStyle      `json:"pageStyle"`
+	Sections  []*TextSection `json:"sections"`
+}
+
+// MarshalJSON encodes the style, referencing its font by name.
+func (s TextStyle) MarshalJSON() ([]byte, error) {
+	out := textStyleJSON{
+		Color:       s.Color,
+		Scale:       s.Scale,
+		LineSpacing: s.LineSpacing,
+		CharSpacing: s.CharSpacing,
+		WordSpacing: s.WordSpacing,
+		HAlign:      s.HAlign,
+		VAlign:      s.VAlign,
+		WordWrap:    s.WordWrap,
+
+		Underline:     s.Underline,
+		Strikethrough: s.Strikethrough,
+		Bold:          s.Bold,
+		RoundCaps:     s.RoundCaps,
+
+		Dashed:     s.Dashed,
+		DashLength: s.DashLength,
+		DashGap:    s.DashGap,
+	}
+	if s.Font != nil {
+		out.Font = s.Font.FontName
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes the style, resolving its font name through
+// DefaultFontRegistry.
+func (s *TextStyle) UnmarshalJSON(data []byte) error {
+	var in textStyleJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+
+	*s = TextStyle{
+		Color:       in.Color,
+		Scale:       in.Scale,
+		LineSpacing: in.LineSpacing,
+		CharSpacing: in.CharSpacing,
+		WordSpacing: in.WordSpacing,
+		HAlign:      in.HAlign,
+		VAlign:      in.VAlign,
+		WordWrap:    in.WordWrap,
+
+		Underline:     in.Underline,
+		Strikethrough: in.Strikethrough,
+		Bold:          in.Bold,
+		RoundCaps:     in.RoundCaps,
+
+		Dashed:     in.Dashed,
+		DashLength: in.DashLength,
+		DashGap:    in.DashGap,
+	}
+	if in.Font != "" {
+		s.Font = DefaultFontRegistry.Get(in.Font)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the section's text and styles. The layout region and
+// document back-reference are not serialized.
+func (section *TextSection) MarshalJSON() ([]byte, error) {
+	return json.Marshal(textSectionJSON{
+		Title:      section.Title,
+		Content:    section.Content,
+		Items:      section.Items,
+		Ordered:    section.Ordered,
+		Style:      section.Style,
+		TitleStyle: section.TitleStyle,
+	})
+}
+
+// UnmarshalJSON decodes the section's text and styles.
+func (section *TextSection) UnmarshalJSON(data []byte) error {
+	var in textSectionJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+
+	section.Title = in.Title
+	section.Content = in.Content
+	section.Items = in.Items
+	section.Ordered = in.Ordered
+	section.Style = in.Style
+	section.TitleStyle = in.TitleStyle
+	return nil
+}
+
+// MarshalJSON encodes the document's settings and sections.
+// The screen and computed layout are not serialized.
+func (doc *TextDocument) MarshalJSON() ([]byte, error) {
+	return json.Marshal(textDocumentJSON{
+		Columns:   doc.Columns,
+		Padding:   doc.Padding,
+		PageStyle: doc.PageStyle,
+		Sections:  doc.Sections,
+	})
+}
+
+// UnmarshalJSON decodes the document's settings and sections.
+// The screen is left unchanged and Layout must be called afterward.
+func (doc *TextDocument) UnmarshalJSON(data []byte) error {
+	var in textDocumentJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+
+	doc.Columns = in.Columns
+	doc.Padding = in.Padding
+	doc.PageStyle = in.PageStyle
+	doc.Sections = make([]*TextSection, 0, len(in.Sections))
+	for _, section := range in.Sections {
+		if section == nil {
+			continue
+		}
+		section.Document = doc
+		doc.Sections = append(doc.Sections, section)
+	}
+	return nil
+}
+
+// UnmarshalTextDocument decodes a document from JSON and attaches it to the
+// given screen. Layout is not performed; call Layout before rendering.
+func UnmarshalTextDocument(data []byte, screen *TextScreen) (*TextDocument, error) {
+	doc := &TextDocument{Screen: screen}
+	if err := json.Unmarshal(data, doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
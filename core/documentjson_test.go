@@ -0,0 +1,109 @@
+package core
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTextDocument_JSONRoundTrip(t *testing.T) {
+	titleFont := newTestFont()
+	titleFont.FontName = "TestTitle"
+	bodyFont := newTestFont()
+	bodyFont.FontName = "TestBody"
+	DefaultFontRegistry.Register(titleFont)
+	DefaultFontRegistry.Register(bodyFont)
+
+	screen := NewTextScreen(Vec3{}, 600, 400, 1.0)
+	doc := NewTextDocument(screen, 2, 20)
+	doc.PageStyle.Font = bodyFont
+
+	intro := doc.AddSection("Intro", "Hello\nworld")
+	intro.SetStyle(TextStyle{
+		Font:        bodyFont,
+		Color:       ColorGreen,
+		Scale:       1.0,
+		LineSpacing: 1.5,
+		HAlign:      AlignLeft,
+		VAlign:      AlignTop,
+		WordWrap:    true,
+	})
+	intro.SetTitleStyle(TextStyle{
+		Font:        titleFont,
+		Color:       ColorOrange,
+		Scale:       2.0,
+		LineSpacing: 1.2,
+		HAlign:      AlignCenter,
+		VAlign:      AlignMiddle,
+		Underline:   true,
+	})
+
+	outro := doc.AddSection("", "Goodbye")
+	outro.SetStyle(TextStyle{
+		Font:          titleFont,
+		Color:         ColorSkyBlue,
+		Scale:         0.8,
+		LineSpacing:   1.1,
+		CharSpacing:   0.5,
+		HAlign:        AlignRight,
+		VAlign:        AlignBottom,
+		Strikethrough: true,
+		Bold:          true,
+		RoundCaps:     true,
+	})
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	newScreen := NewTextScreen(Vec3{}, 600, 400, 1.0)
+	loaded, err := UnmarshalTextDocument(data, newScreen)
+	if err != nil {
+		t.Fatalf("UnmarshalTextDocument: %v", err)
+	}
+
+	if loaded.Screen != newScreen {
+		t.Error("loaded document should use the given screen")
+	}
+	if loaded.Columns != 2 || loaded.Padding != 20 {
+		t.Errorf("Columns/Padding = %d/%f, want 2/20", loaded.Columns, loaded.Padding)
+	}
+	if loaded.PageStyle != doc.PageStyle {
+		t.Errorf("PageStyle = %+v, want %+v", loaded.PageStyle, doc.PageStyle)
+	}
+	if len(loaded.Sections) != 2 {
+		t.Fatalf("loaded %d sections, want 2", len(loaded.Sections))
+	}
+
+	for i, want := range doc.Sections {
+		got := loaded.Sections[i]
+		if got.Title != want.Title || got.Content != want.Content {
+			t.Errorf("section %d text = %q/%q, want %q/%q", i, got.Title, got.Content, want.Title, want.Content)
+		}
+		if got.Style != want.Style {
+			t.Errorf("section %d Style = %+v, want %+v", i, got.Style, want.Style)
+		}
+		if got.TitleStyle != want.TitleStyle {
+			t.Errorf("section %d TitleStyle = %+v, want %+v", i, got.TitleStyle, want.TitleStyle)
+		}
+		if got.Document != loaded {
+			t.Errorf("section %d Document should point to the loaded document", i)
+		}
+		if got.Region != nil {
+			t.Errorf("section %d Region should be nil until Layout is called", i)
+		}
+	}
+
+	if name := loaded.Sections[0].TitleStyle.Font.FontName; name != "TestTitle" {
+		t.Errorf("title font name = %q, want TestTitle", name)
+	}
+	if name := loaded.Sections[0].Style.Font.FontName; name != "TestBody" {
+		t.Errorf("body font name = %q, want TestBody", name)
+	}
+}
+
+func TestUnmarshalTextDocument_InvalidJSON(t *testing.T) {
+	if _, err := UnmarshalTextDocument([]byte("{"), nil); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+}
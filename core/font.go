@@ -4,6 +4,10 @@
 package core
 
 import (
+	"math"
+	"strings"
+	"sync"
+
 	"github.com/chazu/hershey-go"
 )
 
@@ -21,28 +25,250 @@ type HersheyGlyph struct {
 	RealWidth int      // Actual width used for spacing calculations
 	Size      int      // Number of strokes in the glyph
 	Strokes   []Stroke // Collection of line segments that form the glyph
+	Missing   bool     // The font had no drawing for this character
+}
+
+// MissingGlyphMode selects what a font draws for characters it has no
+// glyph for.
+type MissingGlyphMode int
+
+const (
+	// MissingGlyphBox draws a crossed box in place of the character.
+	MissingGlyphBox MissingGlyphMode = iota
+	// MissingGlyphBlank leaves a gap the width of the missing glyph.
+	MissingGlyphBlank
+	// MissingGlyphCustom draws the font's MissingGlyph instead.
+	MissingGlyphCustom
+)
+
+// missingGlyphStrokes is the crossed box drawn by MissingGlyphBox.
+var missingGlyphStrokes = []Stroke{
+	{From: Vec2{X: 0, Y: 0}, To: Vec2{X: 8, Y: 8}},
+	{From: Vec2{X: 0, Y: 8}, To: Vec2{X: 8, Y: 0}},
+	{From: Vec2{X: 2, Y: 2}, To: Vec2{X: 6, Y: 2}},
+	{From: Vec2{X: 6, Y: 2}, To: Vec2{X: 6, Y: 6}},
+	{From: Vec2{X: 6, Y: 6}, To: Vec2{X: 2, Y: 6}},
+	{From: Vec2{X: 2, Y: 6}, To: Vec2{X: 2, Y: 2}},
+}
+
+// DefaultBoldWeight is the stroke weight, in font units, used for bold text.
+const DefaultBoldWeight = 0.75
+
+// GlyphStrokes returns the strokes to draw for a glyph at the given weight.
+// A weight of 0 or less gives the glyph's own strokes. A positive weight
+// fakes boldness: each stroke is followed by two copies offset weight font
+// units to either side, perpendicular to it, so bold glyphs have three
+// times as many strokes. Dots and other zero-length strokes are offset
+// horizontally.
+func GlyphStrokes(glyph HersheyGlyph, weight float32) []Stroke {
+	if weight <= 0 {
+		return glyph.Strokes
+	}
+
+	strokes := make([]Stroke, 0, len(glyph.Strokes)*3)
+	for _, stroke := range glyph.Strokes {
+		dx := stroke.To.X - stroke.From.X
+		dy := stroke.To.Y - stroke.From.Y
+		length := float32(math.Sqrt(float64(dx*dx + dy*dy)))
+
+		offset := Vec2{X: weight}
+		if length > 0 {
+			offset = Vec2{X: -dy / length * weight, Y: dx / length * weight}
+		}
+
+		strokes = append(strokes,
+			stroke,
+			Stroke{
+				From: Vec2{X: stroke.From.X + offset.X, Y: stroke.From.Y + offset.Y},
+				To:   Vec2{X: stroke.To.X + offset.X, Y: stroke.To.Y + offset.Y},
+			},
+			Stroke{
+				From: Vec2{X: stroke.From.X - offset.X, Y: stroke.From.Y - offset.Y},
+				To:   Vec2{X: stroke.To.X - offset.X, Y: stroke.To.Y - offset.Y},
+			},
+		)
+	}
+	return strokes
+}
+
+// StrokeCaps returns the points at which round caps are drawn for strokes:
+// both ends of every stroke, with the point where one stroke continues
+// from the end of the previous one included once.
+func StrokeCaps(strokes []Stroke) []Vec2 {
+	caps := make([]Vec2, 0, len(strokes)*2)
+	for i, stroke := range strokes {
+		if i == 0 || stroke.From != strokes[i-1].To {
+			caps = append(caps, stroke.From)
+		}
+		if stroke.To != stroke.From {
+			caps = append(caps, stroke.To)
+		}
+	}
+	return caps
+}
+
+// DashStrokes splits each stroke into dashes dashLength long separated by
+// gapLength, in the strokes' units, with the pattern restarting at the
+// start of every stroke. Dashes are laid out by DashSegments, the same as
+// dashed hex edges. Zero-length strokes, such as dots, are kept whole.
+func DashStrokes(strokes []Stroke, dashLength, gapLength float32) []Stroke {
+	dashed := make([]Stroke, 0, len(strokes))
+	for _, stroke := range strokes {
+		if stroke.From == stroke.To {
+			dashed = append(dashed, stroke)
+			continue
+		}
+
+		from := Vec3{X: stroke.From.X, Y: stroke.From.Y}
+		to := Vec3{X: stroke.To.X, Y: stroke.To.Y}
+		for _, dash := range DashSegments(from, to, dashLength, gapLength, 0) {
+			dashed = append(dashed, Stroke{
+				From: Vec2{X: dash[0].X, Y: dash[0].Y},
+				To:   Vec2{X: dash[1].X, Y: dash[1].Y},
+			})
+		}
+	}
+	return dashed
 }
 
 // HersheyFont represents a complete Hershey font with all its glyphs.
 // It provides methods for calculating text dimensions and accessing glyph data.
 type HersheyFont struct {
-	Glyphs   map[int]HersheyGlyph // Map of ASCII values (minus 31) to glyphs
-	Height   int                  // Standard height of the font
-	FontName string               // Name of the font from hershey-go library
+	Glyphs          map[int]HersheyGlyph // Map of ASCII values (minus 31) to glyphs
+	Height          int                  // Standard height of the font
+	FontName        string               // Name of the font from hershey-go library
+	MinGlyphSpacing float32              // Minimum advance for glyphs measured by RealWidth
+
+	// Kerning tightens specific character pairs: the pen moves back
+	// Kerning[[2]rune{left, right}] font units between left and right.
+	// Negative values loosen a pair. Nil means no kerning.
+	Kerning map[[2]rune]float32
+
+	// MissingGlyphMode selects what Glyph returns for characters the font
+	// has no drawing for, and MissingGlyph is the placeholder used by
+	// MissingGlyphCustom.
+	MissingGlyphMode MissingGlyphMode
+	MissingGlyph     HersheyGlyph
+
+	// Ascent and Descent are how far letters and digits reach above and
+	// below the glyph origin, in font units, as set by MeasureMetrics when
+	// the font is loaded. Fonts without them use Metrics' fallback.
+	Ascent  float32
+	Descent float32
 }
 
+// metricsSample holds the characters MeasureMetrics measures.
+const metricsSample = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+// DefaultMinGlyphSpacing is the default minimum glyph advance, in font units.
+// It keeps very narrow glyphs from crowding their neighbors.
+const DefaultMinGlyphSpacing = 5
+
 // NewHersheyFont creates a new empty Hershey font with default settings.
 func NewHersheyFont() *HersheyFont {
 	return &HersheyFont{
-		Glyphs:   make(map[int]HersheyGlyph),
-		Height:   32,
-		FontName: "Simplex",
+		Glyphs:          make(map[int]HersheyGlyph),
+		Height:          32,
+		FontName:        "Simplex",
+		MinGlyphSpacing: DefaultMinGlyphSpacing,
 	}
 }
 
-// GetGlyph returns the glyph for a character, or nil if not found.
-func (hf *HersheyFont) GetGlyph(char rune) *HersheyGlyph {
+// GlyphSpacing returns the horizontal space a glyph occupies in font units,
+// not including character spacing. Glyphs measured by RealWidth are widened
+// to at least MinGlyphSpacing.
+func (hf *HersheyFont) GlyphSpacing(glyph HersheyGlyph) float32 {
+	if glyph.RealWidth > 0 {
+		spacing := float32(glyph.RealWidth)
+		if spacing < hf.MinGlyphSpacing {
+			spacing = hf.MinGlyphSpacing
+		}
+		return spacing
+	}
+	return float32(glyph.Width)
+}
+
+// Kern returns the kerning adjustment, in font units, between left and
+// right when drawn next to each other. The pen moves back by this amount.
+func (hf *HersheyFont) Kern(left, right rune) float32 {
+	return hf.Kerning[[2]rune{left, right}]
+}
+
+// HasGlyph reports whether the font has a drawing for a character, as
+// opposed to a placeholder chosen by MissingGlyphMode.
+func (hf *HersheyFont) HasGlyph(char rune) bool {
 	glyph, exists := hf.Glyphs[int(char)-31]
+	return exists && !glyph.Missing
+}
+
+// Glyph returns the glyph to draw for a character. Characters the font has
+// no drawing for get a placeholder according to MissingGlyphMode; exists is
+// false if there is nothing to draw or measure, in which case callers
+// leave a fixed 8 unit gap.
+func (hf *HersheyFont) Glyph(char rune) (glyph HersheyGlyph, exists bool) {
+	glyph, exists = hf.Glyphs[int(char)-31]
+	if exists && !glyph.Missing {
+		return glyph, true
+	}
+
+	switch hf.MissingGlyphMode {
+	case MissingGlyphCustom:
+		glyph, exists = hf.MissingGlyph, true
+	case MissingGlyphBlank:
+		glyph.Strokes = nil
+	default:
+		if exists {
+			glyph.Strokes = missingGlyphStrokes
+		}
+	}
+	glyph.Size = len(glyph.Strokes)
+	return glyph, exists
+}
+
+// MeasureMetrics sets Ascent and Descent from the highest and lowest
+// stroke points of the font's letters and digits. They are left unchanged
+// if the font has none of those glyphs.
+func (hf *HersheyFont) MeasureMetrics() {
+	top, bottom := float32(-math.MaxFloat32), float32(math.MaxFloat32)
+	for _, char := range metricsSample {
+		glyph, exists := hf.Glyphs[int(char)-31]
+		if !exists || glyph.Missing {
+			continue
+		}
+		for _, stroke := range glyph.Strokes {
+			top = max(top, stroke.From.Y, stroke.To.Y)
+			bottom = min(bottom, stroke.From.Y, stroke.To.Y)
+		}
+	}
+	if top < bottom {
+		return
+	}
+	hf.Ascent, hf.Descent = top, -bottom
+}
+
+// Metrics returns the font's Ascent and Descent. Fonts without measured
+// metrics are taken to reach 80% of Height above the origin and the
+// remaining 20% below it.
+func (hf *HersheyFont) Metrics() (ascent, descent float32) {
+	if hf.Ascent+hf.Descent > 0 {
+		return hf.Ascent, hf.Descent
+	}
+	height := float32(hf.Height)
+	return height * 0.8, height * 0.2
+}
+
+// GlyphComplexity returns the number of strokes drawn for a character, as
+// returned by Glyph, as a measure of its rendering cost. Characters with
+// nothing to draw have 0.
+func (hf *HersheyFont) GlyphComplexity(char rune) int {
+	glyph, _ := hf.Glyph(char)
+	return len(glyph.Strokes)
+}
+
+// GetGlyph returns the glyph for a character as returned by Glyph, or nil if
+// not found.
+func (hf *HersheyFont) GetGlyph(char rune) *HersheyGlyph {
+	glyph, exists := hf.Glyph(char)
 	if !exists {
 		return nil
 	}
@@ -51,33 +277,68 @@ func (hf *HersheyFont) GetGlyph(char rune) *HersheyGlyph {
 
 // MeasureText calculates the width of a text string at the given scale.
 func (hf *HersheyFont) MeasureText(text string, scale float32) float32 {
-	totalWidth := float32(0)
+	_, totalWidth := hf.GlyphAdvances(text, scale)
+	return totalWidth
+}
+
+// MeasureTextBlock returns the size of text at the given scale when each
+// newline starts a new line lineSpacing line heights below the last: the
+// width of the widest line and the height from the ascent of the first line
+// to the descent of the last, matching TextRegion.CalculateTextHeight.
+// Empty text measures zero.
+func (hf *HersheyFont) MeasureTextBlock(text string, scale, lineSpacing float32) (width, height float32) {
+	if text == "" {
+		return 0, 0
+	}
+
+	lines := strings.Split(text, "\n")
+	for _, line := range lines {
+		width = max(width, hf.MeasureText(line, scale))
+	}
+
+	ascent, descent := hf.Metrics()
+	height = (ascent + descent) * scale
+	height += float32(len(lines)-1) * float32(hf.Height) * scale * lineSpacing
+	return width, height
+}
+
+// GlyphAdvances returns the pen offset of each rune in text from the start
+// of the text at the given scale, in reading order, along with the total
+// width. Non-printable characters advance nothing; characters without a
+// glyph advance a fixed 8 units. Kerned pairs are drawn closer together.
+func (hf *HersheyFont) GlyphAdvances(text string, scale float32) ([]float32, float32) {
+	offsets := make([]float32, 0, len(text))
+	pen := float32(0)
+	prev := rune(0)
 
 	for _, char := range text {
+		pen -= hf.Kern(prev, char) * scale
+		prev = char
+
+		offsets = append(offsets, pen)
 		if char < 32 || char > 126 {
 			continue
 		}
 
-		glyph, exists := hf.Glyphs[int(char)-31]
+		glyph, exists := hf.Glyph(char)
 		if !exists {
-			totalWidth += 8 * scale
+			pen += 8 * scale
 			continue
 		}
 
-		if glyph.RealWidth > 0 {
-			spacing := float32(glyph.RealWidth)
-			if spacing < 5 {
-				spacing = 5
-			}
-			totalWidth += spacing * scale
-		} else {
-			totalWidth += float32(glyph.Width) * scale
-		}
-
-		totalWidth += 1.0 * scale // Character spacing
+		pen += hf.GlyphSpacing(glyph) * scale
+		pen += 1.0 * scale // Character spacing
 	}
 
-	return totalWidth
+	return offsets, pen
+}
+
+// ScaleForSize returns the scale at which the font is size units tall.
+func (hf *HersheyFont) ScaleForSize(size float32) float32 {
+	if hf.Height <= 0 {
+		return 1
+	}
+	return size / float32(hf.Height)
 }
 
 // loadHersheyGlyph loads a single glyph from the hershey-go package.
@@ -114,7 +375,7 @@ func loadHersheyGlyph(fontName string, char rune) HersheyGlyph {
 
 	minX, _, maxX, _, err := hershey.StringBounds(fontName, 1, 0, 0, string(char))
 	if err != nil {
-		return HersheyGlyph{Width: 16, RealWidth: 16, Size: 0, Strokes: []Stroke{}}
+		return HersheyGlyph{Width: 16, RealWidth: 16, Size: 0, Strokes: []Stroke{}, Missing: true}
 	}
 
 	width := maxX - minX
@@ -122,7 +383,7 @@ func loadHersheyGlyph(fontName string, char rune) HersheyGlyph {
 	drawX, drawY := 0, 0
 	err = hershey.DrawChar(char, fontName, 1, &drawX, &drawY, moveFn, lineFn)
 	if err != nil {
-		return HersheyGlyph{Width: 16, RealWidth: 16, Size: 0, Strokes: []Stroke{}}
+		return HersheyGlyph{Width: 16, RealWidth: 16, Size: 0, Strokes: []Stroke{}, Missing: true}
 	}
 
 	if len(vectorX) >= 2 {
@@ -137,19 +398,9 @@ func loadHersheyGlyph(fontName string, char rune) HersheyGlyph {
 		}
 	}
 
-	// Create marker for missing glyphs
-	if len(strokes) == 0 && char != ' ' && char != '\t' && char != '\n' && char != '\r' {
-		size := float32(8)
-		center := float32(4)
-		strokes = []Stroke{
-			{From: Vec2{X: 0, Y: 0}, To: Vec2{X: size, Y: size}},
-			{From: Vec2{X: 0, Y: size}, To: Vec2{X: size, Y: 0}},
-			{From: Vec2{X: center - 2, Y: center - 2}, To: Vec2{X: center + 2, Y: center - 2}},
-			{From: Vec2{X: center + 2, Y: center - 2}, To: Vec2{X: center + 2, Y: center + 2}},
-			{From: Vec2{X: center + 2, Y: center + 2}, To: Vec2{X: center - 2, Y: center + 2}},
-			{From: Vec2{X: center - 2, Y: center + 2}, To: Vec2{X: center - 2, Y: center - 2}},
-		}
-	}
+	// Fonts without a drawing for the character draw a placeholder; see
+	// HersheyFont.Glyph
+	missing := len(strokes) == 0 && char != '\t' && char != '\n' && char != '\r'
 
 	if width <= 0 {
 		width = 16
@@ -160,6 +411,7 @@ func loadHersheyGlyph(fontName string, char rune) HersheyGlyph {
 		RealWidth: drawX,
 		Size:      len(strokes),
 		Strokes:   strokes,
+		Missing:   missing,
 	}
 }
 
@@ -172,6 +424,7 @@ func LoadHersheyFontData() *HersheyFont {
 		glyph := loadHersheyGlyph(font.FontName, rune(i))
 		font.Glyphs[i-31] = glyph
 	}
+	font.MeasureMetrics()
 
 	return font
 }
@@ -188,6 +441,47 @@ func LoadHersheyFontByName(fontName string) *HersheyFont {
 		glyph := loadHersheyGlyph(fontName, rune(i))
 		font.Glyphs[i-31] = glyph
 	}
+	font.MeasureMetrics()
 
 	return font
 }
+
+// FontRegistry caches fonts by name so they can be shared and referenced
+// by name, e.g. when loading documents from data files.
+type FontRegistry struct {
+	mu    sync.Mutex
+	fonts map[string]*HersheyFont
+}
+
+// NewFontRegistry creates an empty font registry.
+func NewFontRegistry() *FontRegistry {
+	return &FontRegistry{
+		fonts: make(map[string]*HersheyFont),
+	}
+}
+
+// DefaultFontRegistry is the registry used to resolve font names when
+// unmarshaling styles and documents.
+var DefaultFontRegistry = NewFontRegistry()
+
+// Register adds a font to the registry under its FontName, replacing any
+// font previously registered with that name.
+func (r *FontRegistry) Register(font *HersheyFont) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fonts[font.FontName] = font
+}
+
+// Get returns the font registered under name. Fonts that have not been
+// registered are loaded with LoadHersheyFontByName and cached.
+func (r *FontRegistry) Get(name string) *HersheyFont {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if font, ok := r.fonts[name]; ok {
+		return font
+	}
+	font := LoadHersheyFontByName(name)
+	r.fonts[name] = font
+	return font
+}
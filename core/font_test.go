@@ -0,0 +1,313 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestHersheyFont_MinGlyphSpacing(t *testing.T) {
+	font := newTestFont()
+	font.Glyphs[int('.')-31] = HersheyGlyph{Width: 2, RealWidth: 2}
+	font.Glyphs[int('i')-31] = HersheyGlyph{Width: 2, RealWidth: 2}
+
+	if font.MinGlyphSpacing != DefaultMinGlyphSpacing {
+		t.Fatalf("MinGlyphSpacing = %f, want default %d", font.MinGlyphSpacing, DefaultMinGlyphSpacing)
+	}
+
+	line := "i.i.i.i."
+	wide := font.MeasureText(line, 1.0)
+	if wide != 8*(5+1) {
+		t.Errorf("MeasureText with default floor = %f, want %d", wide, 8*(5+1))
+	}
+
+	font.MinGlyphSpacing = 1
+	narrow := font.MeasureText(line, 1.0)
+	if narrow != 8*(2+1) {
+		t.Errorf("MeasureText with floor 1 = %f, want %d", narrow, 8*(2+1))
+	}
+	if narrow >= wide {
+		t.Errorf("lowering the floor should narrow the line: %f >= %f", narrow, wide)
+	}
+}
+
+func TestHersheyFont_MeasurementMatchesLayout(t *testing.T) {
+	font := newTestFont()
+	font.Glyphs[int('.')-31] = HersheyGlyph{Width: 2, RealWidth: 2}
+	font.Glyphs[int('l')-31] = HersheyGlyph{Width: 3, RealWidth: 0}
+
+	for _, floor := range []float32{0, 1, 3, DefaultMinGlyphSpacing, 8} {
+		font.MinGlyphSpacing = floor
+		region := newTestRegion(1000, 100, "")
+		region.Font = font
+
+		line := "a.l.b..l"
+		measured := font.MeasureText(line, 1.5)
+		laidOut := region.CalculateLineWidth(line, 1.5)
+		if measured != laidOut {
+			t.Errorf("floor %f: MeasureText = %f, CalculateLineWidth = %f", floor, measured, laidOut)
+		}
+	}
+}
+
+func TestHersheyFont_GlyphAdvances(t *testing.T) {
+	font := newTestFont()
+
+	offsets, width := font.GlyphAdvances("ab\nc", 2)
+	want := []float32{0, 22, 44, 44}
+	if len(offsets) != len(want) {
+		t.Fatalf("got %d offsets, want %d", len(offsets), len(want))
+	}
+	for i := range want {
+		if offsets[i] != want[i] {
+			t.Errorf("offset[%d] = %f, want %f", i, offsets[i], want[i])
+		}
+	}
+	if width != 66 {
+		t.Errorf("width = %f, want 66", width)
+	}
+	if got := font.MeasureText("ab\nc", 2); got != width {
+		t.Errorf("MeasureText = %f, want %f to match GlyphAdvances", got, width)
+	}
+}
+
+func TestHersheyFont_ScaleForSize(t *testing.T) {
+	font := newTestFont()
+
+	if got := font.ScaleForSize(16); got != 0.5 {
+		t.Errorf("ScaleForSize(16) = %f, want 0.5", got)
+	}
+
+	font.Height = 0
+	if got := font.ScaleForSize(16); got != 1 {
+		t.Errorf("ScaleForSize with zero height = %f, want 1", got)
+	}
+}
+
+func TestGlyphStrokes(t *testing.T) {
+	glyph := HersheyGlyph{Strokes: []Stroke{
+		{From: Vec2{X: 0, Y: -5}, To: Vec2{X: 0, Y: 5}},
+		{From: Vec2{X: 3, Y: 3}, To: Vec2{X: 3, Y: 3}},
+	}}
+
+	if got := GlyphStrokes(glyph, 0); len(got) != 2 {
+		t.Errorf("weight 0 gave %d strokes, want the glyph's 2", len(got))
+	}
+
+	got := GlyphStrokes(glyph, 1)
+	want := []Stroke{
+		// The vertical stroke is offset sideways
+		{From: Vec2{X: 0, Y: -5}, To: Vec2{X: 0, Y: 5}},
+		{From: Vec2{X: -1, Y: -5}, To: Vec2{X: -1, Y: 5}},
+		{From: Vec2{X: 1, Y: -5}, To: Vec2{X: 1, Y: 5}},
+		// The dot is offset horizontally
+		{From: Vec2{X: 3, Y: 3}, To: Vec2{X: 3, Y: 3}},
+		{From: Vec2{X: 4, Y: 3}, To: Vec2{X: 4, Y: 3}},
+		{From: Vec2{X: 2, Y: 3}, To: Vec2{X: 2, Y: 3}},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("weight 1 gave %d strokes, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("stroke %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestStrokeCaps(t *testing.T) {
+	capsEqual := func(got, want []Vec2) bool {
+		if len(got) != len(want) {
+			return false
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	// A single stroke is capped at both ends
+	single := []Stroke{{From: Vec2{X: 1, Y: -4}, To: Vec2{X: 6, Y: 8}}}
+	if got, want := StrokeCaps(single), []Vec2{{X: 1, Y: -4}, {X: 6, Y: 8}}; !capsEqual(got, want) {
+		t.Errorf("single stroke caps = %v, want %v", got, want)
+	}
+
+	// A joint is capped once, a dot once, and a separate stroke at both ends
+	strokes := []Stroke{
+		{From: Vec2{X: 0, Y: 0}, To: Vec2{X: 5, Y: 0}},
+		{From: Vec2{X: 5, Y: 0}, To: Vec2{X: 5, Y: 5}},
+		{From: Vec2{X: 2, Y: 2}, To: Vec2{X: 2, Y: 2}},
+		{From: Vec2{X: 0, Y: 9}, To: Vec2{X: 5, Y: 9}},
+	}
+	want := []Vec2{{X: 0, Y: 0}, {X: 5, Y: 0}, {X: 5, Y: 5}, {X: 2, Y: 2}, {X: 0, Y: 9}, {X: 5, Y: 9}}
+	if got := StrokeCaps(strokes); !capsEqual(got, want) {
+		t.Errorf("caps = %v, want %v", got, want)
+	}
+
+	if got := StrokeCaps(nil); len(got) != 0 {
+		t.Errorf("no strokes gave caps %v", got)
+	}
+}
+
+func TestHersheyFont_Kerning(t *testing.T) {
+	font := newTestFont()
+	if got := font.Kern('A', 'V'); got != 0 {
+		t.Errorf("Kern without a table = %f, want 0", got)
+	}
+	plain := font.MeasureText("AV", 1)
+
+	font.Kerning = map[[2]rune]float32{{'A', 'V'}: 3}
+
+	av, ax := font.MeasureText("AV", 1), font.MeasureText("AX", 1)
+	if av != plain-3 {
+		t.Errorf("MeasureText(AV) = %f, want %f", av, plain-3)
+	}
+	if av >= ax {
+		t.Errorf("MeasureText(AV) = %f, want narrower than AX = %f", av, ax)
+	}
+	// Kerning applies to the pair in order only
+	if got := font.MeasureText("VA", 1); got != plain {
+		t.Errorf("MeasureText(VA) = %f, want unkerned %f", got, plain)
+	}
+
+	offsets, _ := font.GlyphAdvances("AVA", 2)
+	if want := []float32{0, 16, 38}; offsets[1] != want[1] || offsets[2] != want[2] {
+		t.Errorf("GlyphAdvances(AVA) = %v, want %v", offsets, want)
+	}
+
+	region := newTestRegion(500, 100, "AV")
+	region.Font = font
+	if got := region.CalculateLineWidth("AV", 1); got != av {
+		t.Errorf("CalculateLineWidth(AV) = %f, want %f to match MeasureText", got, av)
+	}
+}
+
+func TestHersheyFont_MeasureTextBlock(t *testing.T) {
+	font := newTestFont()
+
+	tests := []struct {
+		name          string
+		text          string
+		width, height float32
+	}{
+		{"empty", "", 0, 0},
+		// Each glyph advances 11 units; lines are 32 tall
+		{"single line", "abc", 33, 32},
+		// Three lines 32 tall with two 1.5 line gaps: 32 + 2*48
+		{"multi-line", "ab\nabcd\nc", 44, 128},
+		{"trailing newline", "ab\n", 22, 80},
+	}
+
+	for _, tt := range tests {
+		width, height := font.MeasureTextBlock(tt.text, 1, 1.5)
+		if width != tt.width || height != tt.height {
+			t.Errorf("%s: MeasureTextBlock = %g x %g, want %g x %g", tt.name, width, height, tt.width, tt.height)
+		}
+	}
+
+	// Matches a region's own measurement of the same lines
+	region := newTestRegion(500, 500, "")
+	region.LineSpacing = 1.5
+	region.Scale = 2
+	_, height := font.MeasureTextBlock("ab\nabcd\nc", 2, 1.5)
+	if want := region.CalculateTextHeight([]string{"ab", "abcd", "c"}); height != want {
+		t.Errorf("MeasureTextBlock height = %g, want CalculateTextHeight %g", height, want)
+	}
+}
+
+func TestHersheyFont_Metrics(t *testing.T) {
+	// Simplex capitals reach 12 units up, with "b", "d" and "l" a little
+	// higher; "g", "j", "p", "q" and "y" reach 16 below
+	simplex := LoadHersheyFontData()
+	if simplex.Ascent != 13 || simplex.Descent != 16 {
+		t.Errorf("Simplex metrics = %g, %g, want 13, 16", simplex.Ascent, simplex.Descent)
+	}
+	for _, name := range []string{"Simplex", "Script_Simplex", "Complex_Small", "Gothic_English_Triplex"} {
+		font := LoadHersheyFontByName(name)
+		if font.Ascent <= 0 || font.Descent <= 0 || font.Ascent+font.Descent > 1.1*float32(font.Height) {
+			t.Errorf("%s metrics = %g, %g, want both positive and about within Height %d", name, font.Ascent, font.Descent, font.Height)
+		}
+	}
+	if script := LoadHersheyFontByName("Script_Simplex"); script.Descent <= simplex.Descent {
+		t.Errorf("Script_Simplex descent %g, want more than Simplex's %g", script.Descent, simplex.Descent)
+	}
+
+	// A font without measured metrics falls back to 80% ascent
+	font := newTestFont()
+	if ascent, descent := font.Metrics(); ascent != 25.6 || descent != 6.4 {
+		t.Errorf("fallback Metrics = %g, %g, want 25.6, 6.4", ascent, descent)
+	}
+}
+
+func TestHersheyFont_MissingGlyphMode(t *testing.T) {
+	font := newTestFont()
+	font.Glyphs[int('?')-31] = HersheyGlyph{Width: 12, RealWidth: 12, Missing: true}
+	delete(font.Glyphs, int('~')-31)
+	placeholder := HersheyGlyph{
+		Width:     4,
+		RealWidth: 6,
+		Strokes:   []Stroke{{From: Vec2{X: 0, Y: 0}, To: Vec2{X: 0, Y: 1}}},
+	}
+
+	if !font.HasGlyph('a') || font.HasGlyph('?') || font.HasGlyph('~') {
+		t.Errorf("HasGlyph(a, ?, ~) = %v, %v, %v, want true, false, false",
+			font.HasGlyph('a'), font.HasGlyph('?'), font.HasGlyph('~'))
+	}
+
+	tests := []struct {
+		name    string
+		mode    MissingGlyphMode
+		width   float32 // Width of "a?~"
+		strokes int     // Strokes drawn for '?'
+	}{
+		// 'a' advances 11, '?' its own 12 + 1, '~' the fixed 8
+		{"box", MissingGlyphBox, 32, len(missingGlyphStrokes)},
+		{"blank", MissingGlyphBlank, 32, 0},
+		// The placeholder advances 6 + 1 for both '?' and '~'
+		{"custom", MissingGlyphCustom, 25, 1},
+	}
+
+	for _, tt := range tests {
+		font.MissingGlyphMode = tt.mode
+		font.MissingGlyph = placeholder
+
+		region := newTestRegion(500, 100, "a?~")
+		region.Font = font
+		if got := region.CalculateLineWidth("a?~", 1); got != tt.width {
+			t.Errorf("%s: CalculateLineWidth = %g, want %g", tt.name, got, tt.width)
+		}
+
+		glyph, exists := font.Glyph('?')
+		if !exists || len(glyph.Strokes) != tt.strokes || glyph.Size != tt.strokes {
+			t.Errorf("%s: Glyph('?') = %d strokes (exists %v), want %d", tt.name, len(glyph.Strokes), exists, tt.strokes)
+		}
+		// Drawing a real glyph is unaffected
+		if glyph, _ := font.Glyph('a'); len(glyph.Strokes) != 1 {
+			t.Errorf("%s: Glyph('a') = %d strokes, want 1", tt.name, len(glyph.Strokes))
+		}
+		if got := font.GlyphComplexity('?'); got != tt.strokes {
+			t.Errorf("%s: GlyphComplexity('?') = %d, want %d", tt.name, got, tt.strokes)
+		}
+	}
+}
+
+func TestDashStrokes_MatchesDashSegments(t *testing.T) {
+	// A glyph stroke is dashed exactly like a hex edge along the same line
+	stroke := Stroke{From: Vec2{X: 1, Y: 2}, To: Vec2{X: 13, Y: -7}}
+	edge := DashSegments(Vec3{X: 1, Y: 2}, Vec3{X: 13, Y: -7}, 4, 2, 0)
+
+	dot := Stroke{From: Vec2{X: 5, Y: 5}, To: Vec2{X: 5, Y: 5}}
+	got := DashStrokes([]Stroke{stroke, dot}, 4, 2)
+	if len(got) != len(edge)+1 {
+		t.Fatalf("DashStrokes gave %d strokes, want %d dashes and the dot", len(got), len(edge))
+	}
+	for i, dash := range edge {
+		want := Stroke{From: Vec2{X: dash[0].X, Y: dash[0].Y}, To: Vec2{X: dash[1].X, Y: dash[1].Y}}
+		if got[i] != want {
+			t.Errorf("dash %d = %v, want %v", i, got[i], want)
+		}
+	}
+	if got[len(got)-1] != dot {
+		t.Errorf("dot = %v, want it kept whole", got[len(got)-1])
+	}
+}
@@ -0,0 +1,113 @@
+// Package core provides view frustum culling for the Spectrex framework.
+package core
+
+import "math"
+
+// Plane is the set of points p where Normal.Dot(p) + Distance is zero.
+// Normal is unit length, so that expression is a point's signed distance
+// from the plane, positive on the side Normal points to.
+type Plane struct {
+	Normal   Vec3
+	Distance float32
+}
+
+// newPlane returns the plane through point facing normal.
+func newPlane(normal, point Vec3) Plane {
+	normal = normal.Normalize()
+	return Plane{Normal: normal, Distance: -normal.Dot(point)}
+}
+
+// SignedDistance returns how far point lies in front of the plane;
+// negative values are behind it.
+func (p Plane) SignedDistance(point Vec3) float32 {
+	return p.Normal.Dot(point) + p.Distance
+}
+
+// Frustum is the volume a camera sees, bounded by six planes facing
+// inward, so a point is inside when it is in front of every plane.
+type Frustum struct {
+	Near, Far, Left, Right, Bottom, Top Plane
+}
+
+// Frustum returns the volume the camera sees on a viewport of the given
+// aspect ratio (width over height), between CameraNearPlane and
+// CameraFarPlane.
+func (c Camera) Frustum(aspect float32) Frustum {
+	forward, right, up := c.cameraBasis()
+	f := Frustum{
+		Near: newPlane(forward, c.Position.Add(forward.Scale(CameraNearPlane))),
+		Far:  newPlane(forward.Scale(-1), c.Position.Add(forward.Scale(CameraFarPlane))),
+	}
+
+	if c.Projection == CameraOrthographic {
+		halfHeight := c.Fovy / 2
+		halfWidth := halfHeight * aspect
+		f.Left = newPlane(right, c.Position.Sub(right.Scale(halfWidth)))
+		f.Right = newPlane(right.Scale(-1), c.Position.Add(right.Scale(halfWidth)))
+		f.Bottom = newPlane(up, c.Position.Sub(up.Scale(halfHeight)))
+		f.Top = newPlane(up.Scale(-1), c.Position.Add(up.Scale(halfHeight)))
+		return f
+	}
+
+	// The side planes pass through the camera, each tilted from the view
+	// direction by half the field of view
+	tanHalfHeight := float32(math.Tan(float64(DegToRad(c.Fovy)) / 2))
+	tanHalfWidth := tanHalfHeight * aspect
+	f.Left = newPlane(forward.Scale(tanHalfWidth).Add(right), c.Position)
+	f.Right = newPlane(forward.Scale(tanHalfWidth).Sub(right), c.Position)
+	f.Bottom = newPlane(forward.Scale(tanHalfHeight).Add(up), c.Position)
+	f.Top = newPlane(forward.Scale(tanHalfHeight).Sub(up), c.Position)
+	return f
+}
+
+// Planes returns the frustum's planes in the order near, far, left, right,
+// bottom, top.
+func (f Frustum) Planes() [6]Plane {
+	return [6]Plane{f.Near, f.Far, f.Left, f.Right, f.Bottom, f.Top}
+}
+
+// ContainsSphere reports whether any part of the sphere may be inside the
+// frustum. Spheres near a corner of the frustum can be reported inside
+// when they are just outside it, so it suits culling, not hit testing.
+func (f Frustum) ContainsSphere(center Vec3, radius float32) bool {
+	for _, plane := range f.Planes() {
+		if plane.SignedDistance(center) < -radius {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsBox reports whether any part of the axis-aligned box from min to
+// max may be inside the frustum; see ContainsPoints.
+func (f Frustum) ContainsBox(min, max Vec3) bool {
+	return f.ContainsPoints([]Vec3{
+		{X: min.X, Y: min.Y, Z: min.Z}, {X: max.X, Y: min.Y, Z: min.Z},
+		{X: min.X, Y: max.Y, Z: min.Z}, {X: max.X, Y: max.Y, Z: min.Z},
+		{X: min.X, Y: min.Y, Z: max.Z}, {X: max.X, Y: min.Y, Z: max.Z},
+		{X: min.X, Y: max.Y, Z: max.Z}, {X: max.X, Y: max.Y, Z: max.Z},
+	})
+}
+
+// ContainsPoints reports whether any part of the convex shape with the
+// given corners, such as a box, quad or hex, may be inside the frustum.
+// Like ContainsSphere it errs toward inside, reporting a shape outside
+// only when all its points are behind the same plane.
+func (f Frustum) ContainsPoints(points []Vec3) bool {
+	if len(points) == 0 {
+		return false
+	}
+	for _, plane := range f.Planes() {
+		outside := true
+		for _, p := range points {
+			if plane.SignedDistance(p) >= 0 {
+				outside = false
+				break
+			}
+		}
+		if outside {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,87 @@
+package core
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCamera_Frustum(t *testing.T) {
+	// Looking down +Z with a 90 degree view twice as wide as it is tall,
+	// so the sides slope 2 across per unit of depth and the top and
+	// bottom 1. The camera's right is -X.
+	camera := Camera{Position: Vec3{Y: 5}, Target: Vec3{Y: 5, Z: 10}, Up: Vec3{Y: 1}, Fovy: 90}
+	f := camera.Frustum(2)
+
+	sqrt5, sqrt2 := float32(math.Sqrt(5)), float32(math.Sqrt(2))
+	want := map[string]Plane{
+		"near":   {Normal: Vec3{Z: 1}, Distance: -CameraNearPlane},
+		"far":    {Normal: Vec3{Z: -1}, Distance: CameraFarPlane},
+		"left":   {Normal: Vec3{X: -1 / sqrt5, Z: 2 / sqrt5}},
+		"right":  {Normal: Vec3{X: 1 / sqrt5, Z: 2 / sqrt5}},
+		"bottom": {Normal: Vec3{Y: 1 / sqrt2, Z: 1 / sqrt2}, Distance: -5 / sqrt2},
+		"top":    {Normal: Vec3{Y: -1 / sqrt2, Z: 1 / sqrt2}, Distance: 5 / sqrt2},
+	}
+	got := map[string]Plane{
+		"near": f.Near, "far": f.Far, "left": f.Left,
+		"right": f.Right, "bottom": f.Bottom, "top": f.Top,
+	}
+	for name, plane := range want {
+		if !vec3Near(got[name].Normal, plane.Normal) || math.Abs(float64(got[name].Distance-plane.Distance)) > 1e-4 {
+			t.Errorf("%s plane = %+v, want %+v", name, got[name], plane)
+		}
+	}
+
+	// The corners of the view at depth 10 lie on the side planes
+	for _, corner := range []Vec3{{X: 20, Y: 15, Z: 10}, {X: -20, Y: -5, Z: 10}} {
+		for _, plane := range []Plane{f.Left, f.Right, f.Bottom, f.Top} {
+			if d := plane.SignedDistance(corner); d < -1e-4 {
+				t.Errorf("view corner %v is %g behind plane %+v", corner, -d, plane)
+			}
+		}
+	}
+
+	// An orthographic camera's sides are parallel, half of Fovy from the
+	// view axis vertically and aspect times that horizontally
+	camera.Projection = CameraOrthographic
+	camera.Fovy = 20
+	f = camera.Frustum(2)
+	if d := f.Left.SignedDistance(Vec3{X: 20, Y: 5, Z: 500}); math.Abs(float64(d)) > 1e-4 {
+		t.Errorf("orthographic left plane is %g from x = 20", d)
+	}
+	if d := f.Top.SignedDistance(Vec3{Y: 15, Z: 1}); math.Abs(float64(d)) > 1e-4 {
+		t.Errorf("orthographic top plane is %g from y = 15", d)
+	}
+}
+
+func TestFrustum_ContainsSphere(t *testing.T) {
+	camera := Camera{Target: Vec3{Z: 1}, Up: Vec3{Y: 1}, Fovy: 90}
+	f := camera.Frustum(1)
+
+	tests := []struct {
+		name   string
+		center Vec3
+		radius float32
+		want   bool
+	}{
+		{"ahead", Vec3{Z: 50}, 1, true},
+		{"behind", Vec3{Z: -50}, 1, false},
+		{"behind, reaching past the camera", Vec3{Z: -5}, 6, true},
+		{"beyond the far plane", Vec3{Z: CameraFarPlane + 10}, 1, false},
+		{"off to the side", Vec3{X: 80, Z: 50}, 1, false},
+		{"straddling the side", Vec3{X: 52, Z: 50}, 3, true},
+		{"above", Vec3{Y: -60, Z: 50}, 5, false},
+	}
+	for _, tt := range tests {
+		if got := f.ContainsSphere(tt.center, tt.radius); got != tt.want {
+			t.Errorf("%s: ContainsSphere(%v, %g) = %v, want %v", tt.name, tt.center, tt.radius, got, tt.want)
+		}
+	}
+
+	// A box is outside only when all its corners are behind one plane
+	if !f.ContainsBox(Vec3{X: -100, Y: -1, Z: 10}, Vec3{X: 100, Y: 1, Z: 11}) {
+		t.Error("box spanning the view was culled")
+	}
+	if f.ContainsBox(Vec3{X: 60, Y: -1, Z: 10}, Vec3{X: 100, Y: 1, Z: 20}) {
+		t.Error("box off to the side was kept")
+	}
+}
@@ -21,6 +21,48 @@ func MakePoly(sides int, radius float32, start float32) []Vec3 {
 	return poly
 }
 
+// MakePolyXZ generates a polygon like MakePoly but lying flat on the XZ
+// ground plane (Y=0) that hex grids are drawn on. As with ArcPoints, angle 0
+// points along +X and angles increase toward +Z.
+func MakePolyXZ(sides int, radius float32, start float32) []Vec3 {
+	poly := MakePoly(sides, radius, start)
+	for i, v := range poly {
+		poly[i] = Vec3{X: v.X, Y: 0, Z: v.Y}
+	}
+	return poly
+}
+
+// FanTriangles returns the vertex indices of a triangle fan covering a
+// convex polygon with n vertices: (0, i, i+1) for each i from 1 to n-2,
+// keeping the polygon's winding. Returns nil when n < 3.
+func FanTriangles(n int) [][3]int {
+	if n < 3 {
+		return nil
+	}
+	triangles := make([][3]int, n-2)
+	for i := range triangles {
+		triangles[i] = [3]int{0, i + 1, i + 2}
+	}
+	return triangles
+}
+
+// PolygonEdges returns the vertex index pairs of a polygon outline with n
+// vertices, closing back to the first. Two vertices give one edge, and
+// fewer give none.
+func PolygonEdges(n int) [][2]int {
+	if n < 2 {
+		return nil
+	}
+	if n == 2 {
+		return [][2]int{{0, 1}}
+	}
+	edges := make([][2]int, n)
+	for i := range edges {
+		edges[i] = [2]int{i, (i + 1) % n}
+	}
+	return edges
+}
+
 // TransformPoly applies rotation and translation to a polygon.
 func TransformPoly(poly []Vec3, position Vec3, rotation Vec3) []Vec3 {
 	transformed := make([]Vec3, len(poly))
@@ -72,3 +114,193 @@ func TransformPoly(poly []Vec3, position Vec3, rotation Vec3) []Vec3 {
 
 	return transformed
 }
+
+// TransformPolyMatrix applies a full transformation matrix, such as one from
+// LookAtMatrix or Quaternion.ToMatrix, to a polygon. TransformPoly's Euler
+// rotation and translation is the matrix
+// EulerMatrix(rotation).Multiply(MatrixTranslate(position.X, position.Y, position.Z)).
+func TransformPolyMatrix(poly []Vec3, m Matrix) []Vec3 {
+	transformed := make([]Vec3, len(poly))
+	for i, v := range poly {
+		transformed[i] = m.TransformVec3(v)
+	}
+	return transformed
+}
+
+// LineQuad returns the corners of a quad of the given width covering the
+// segment from start to end, turned to face viewPoint. The corners wind
+// start+side, end+side, end-side, start-side. ok is false when the segment
+// has zero length or points straight at viewPoint, leaving no facing side.
+func LineQuad(start, end, viewPoint Vec3, thickness float32) (corners [4]Vec3, ok bool) {
+	dir := end.Sub(start)
+	mid := start.Add(dir.Scale(0.5))
+	side := dir.Cross(viewPoint.Sub(mid))
+
+	if side.Length() < 1e-6 {
+		return corners, false
+	}
+	side = side.Normalize().Scale(thickness / 2)
+
+	corners[0] = start.Add(side)
+	corners[1] = end.Add(side)
+	corners[2] = end.Sub(side)
+	corners[3] = start.Sub(side)
+	return corners, true
+}
+
+// QuadTriangles splits a flat quad into two triangles along its 0-2
+// diagonal, each wound counter-clockwise as seen from viewPoint, which is
+// the front face that OpenGL-style backends keep when culling back faces.
+// A quad drawn this way stays visible whichever way it is turned.
+func QuadTriangles(corners [4]Vec3, viewPoint Vec3) [2][3]Vec3 {
+	a, b, c, d := corners[0], corners[1], corners[2], corners[3]
+	normal := b.Sub(a).Cross(c.Sub(a))
+	if normal.Dot(viewPoint.Sub(a)) < 0 {
+		return [2][3]Vec3{{a, c, b}, {a, d, c}}
+	}
+	return [2][3]Vec3{{a, b, c}, {a, c, d}}
+}
+
+// DashIntervals returns the start and end distances of the dashes along a
+// line of the given length, for dashes dashLength long separated by
+// gapLength. offset shifts the pattern toward the end of the line, wrapping
+// every dashLength+gapLength, so increasing it each frame makes the dashes
+// crawl along the line. Dashes cut by either end of the line are clipped.
+// Returns nil if the line or the dashes have no length.
+func DashIntervals(length, dashLength, gapLength, offset float32) [][2]float32 {
+	period := dashLength + gapLength
+	if length <= 0 || dashLength <= 0 || period <= 0 {
+		return nil
+	}
+
+	phase := float32(math.Mod(float64(offset), float64(period)))
+	if phase < 0 {
+		phase += period
+	}
+
+	var dashes [][2]float32
+	for pos := phase - period; pos < length; pos += period {
+		start := max(pos, 0)
+		end := min(pos+dashLength, length)
+		if end > start {
+			dashes = append(dashes, [2]float32{start, end})
+		}
+	}
+	return dashes
+}
+
+// DashSegments returns the dashes of the line from start to end, as laid
+// out by DashIntervals. Returns nil for a zero-length line.
+func DashSegments(start, end Vec3, dashLength, gapLength, offset float32) [][2]Vec3 {
+	delta := end.Sub(start)
+	length := delta.Length()
+	if length == 0 {
+		return nil
+	}
+	dir := delta.Scale(1 / length)
+
+	intervals := DashIntervals(length, dashLength, gapLength, offset)
+	dashes := make([][2]Vec3, len(intervals))
+	for i, dash := range intervals {
+		dashes[i] = [2]Vec3{start.Add(dir.Scale(dash[0])), start.Add(dir.Scale(dash[1]))}
+	}
+	return dashes
+}
+
+// ClipSegmentToRect returns the part of the segment from a to b that lies
+// inside the rectangle with corner (x, y) and the given size, keeping its
+// direction. Returns ok=false if no part of it is inside.
+func ClipSegmentToRect(a, b Vec2, x, y, width, height float32) (Vec2, Vec2, bool) {
+	dx, dy := b.X-a.X, b.Y-a.Y
+	t0, t1 := float32(0), float32(1)
+
+	// Liang-Barsky: narrow [t0, t1] against each edge in turn
+	edges := [4][2]float32{
+		{-dx, a.X - x},
+		{dx, x + width - a.X},
+		{-dy, a.Y - y},
+		{dy, y + height - a.Y},
+	}
+	for _, edge := range edges {
+		p, q := edge[0], edge[1]
+		if p == 0 {
+			// Parallel to this edge: entirely inside or outside it
+			if q < 0 {
+				return a, b, false
+			}
+			continue
+		}
+		r := q / p
+		if p < 0 {
+			if r > t1 {
+				return a, b, false
+			}
+			t0 = max(t0, r)
+		} else {
+			if r < t0 {
+				return a, b, false
+			}
+			t1 = min(t1, r)
+		}
+	}
+
+	start, end := a, b
+	if t0 > 0 {
+		start = Vec2{X: a.X + dx*t0, Y: a.Y + dy*t0}
+	}
+	if t1 < 1 {
+		end = Vec2{X: a.X + dx*t1, Y: a.Y + dy*t1}
+	}
+	return start, end, true
+}
+
+// CirclePoints returns segments points evenly spaced around a circle of the
+// given radius about center, in the plane facing normal. The points form a
+// closed loop: the last point connects back to the first. See ArcPoints for
+// the angle convention. segments is raised to at least 3.
+func CirclePoints(center Vec3, radius float32, normal Vec3, segments int) []Vec3 {
+	if segments < 3 {
+		segments = 3
+	}
+	return ArcPoints(center, radius, 0, 360, normal, segments)[:segments]
+}
+
+// ArcPoints returns segments+1 points along an arc of the given radius about
+// center, from startAngle to endAngle in degrees, in the plane facing
+// normal. A zero normal means +Y, the XZ plane, where angle 0 points along
+// +X and angles increase toward +Z. segments is raised to at least 1.
+func ArcPoints(center Vec3, radius, startAngle, endAngle float32, normal Vec3, segments int) []Vec3 {
+	if segments < 1 {
+		segments = 1
+	}
+
+	u, v := planeBasis(normal)
+	start := float64(DegToRad(startAngle))
+	step := float64(DegToRad(endAngle-startAngle)) / float64(segments)
+
+	points := make([]Vec3, segments+1)
+	for i := range points {
+		theta := start + step*float64(i)
+		cos := float32(math.Cos(theta)) * radius
+		sin := float32(math.Sin(theta)) * radius
+		points[i] = center.Add(u.Scale(cos)).Add(v.Scale(sin))
+	}
+	return points
+}
+
+// planeBasis returns two perpendicular unit vectors spanning the plane
+// facing normal, with v = u × normal. For +Y these are +X and +Z.
+func planeBasis(normal Vec3) (u, v Vec3) {
+	n := normal.Normalize()
+	if n == (Vec3{}) {
+		n = Vec3{Y: 1}
+	}
+
+	u = n.Cross(Vec3{Z: 1})
+	if u.Length() < 1e-6 {
+		// Normal is along Z; the plane is XY
+		u = Vec3{X: 1}
+	}
+	u = u.Normalize()
+	return u, u.Cross(n)
+}
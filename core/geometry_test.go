@@ -0,0 +1,326 @@
+package core
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func vec3Near(a, b Vec3) bool {
+	const eps = 1e-4
+	return math.Abs(float64(a.X-b.X)) < eps &&
+		math.Abs(float64(a.Y-b.Y)) < eps &&
+		math.Abs(float64(a.Z-b.Z)) < eps
+}
+
+func TestLineQuad(t *testing.T) {
+	// Segment along X viewed from +Z: the quad spreads along Y
+	start := Vec3{X: 0, Y: 0, Z: 0}
+	end := Vec3{X: 10, Y: 0, Z: 0}
+	view := Vec3{X: 5, Y: 0, Z: 100}
+
+	corners, ok := LineQuad(start, end, view, 2)
+	if !ok {
+		t.Fatal("LineQuad reported a degenerate segment")
+	}
+
+	want := [4]Vec3{
+		{X: 0, Y: -1, Z: 0},
+		{X: 10, Y: -1, Z: 0},
+		{X: 10, Y: 1, Z: 0},
+		{X: 0, Y: 1, Z: 0},
+	}
+	for i := range want {
+		if !vec3Near(corners[i], want[i]) {
+			t.Errorf("corner %d = %+v, want %+v", i, corners[i], want[i])
+		}
+	}
+}
+
+func TestLineQuad_FacesViewer(t *testing.T) {
+	start := Vec3{X: 1, Y: 2, Z: 3}
+	end := Vec3{X: 4, Y: -2, Z: 7}
+	view := Vec3{X: -20, Y: 15, Z: 5}
+
+	corners, ok := LineQuad(start, end, view, 3)
+	if !ok {
+		t.Fatal("LineQuad reported a degenerate segment")
+	}
+
+	side := corners[0].Sub(start)
+	if got := side.Length(); math.Abs(float64(got-1.5)) > 1e-4 {
+		t.Errorf("half width = %f, want 1.5", got)
+	}
+	if d := side.Dot(end.Sub(start)); math.Abs(float64(d)) > 1e-3 {
+		t.Errorf("side not perpendicular to segment, dot = %f", d)
+	}
+	if d := side.Dot(view.Sub(start)); math.Abs(float64(d)) > 1e-3 {
+		t.Errorf("side not perpendicular to view direction, dot = %f", d)
+	}
+}
+
+func TestLineQuad_Degenerate(t *testing.T) {
+	p := Vec3{X: 1, Y: 1, Z: 1}
+	if _, ok := LineQuad(p, p, Vec3{Z: 10}, 1); ok {
+		t.Error("zero-length segment should be degenerate")
+	}
+
+	// Segment pointing straight at the viewer
+	if _, ok := LineQuad(Vec3{}, Vec3{Z: 5}, Vec3{Z: 50}, 1); ok {
+		t.Error("segment aimed at the view point should be degenerate")
+	}
+}
+
+func TestQuadTriangles(t *testing.T) {
+	// A screen background seen from a camera on -Z, as the screen turns
+	// from facing the camera to facing away and back
+	camera := Vec3{Z: -100}
+	for _, yaw := range []float32{0, 45, 90 - 1, 90 + 1, 180, 270} {
+		screen := NewTextScreen(Vec3{}, 40, 30, 1)
+		screen.Rotation = Vec3{Y: yaw}
+		m := screen.GetTransformMatrix()
+		corners := [4]Vec3{
+			m.TransformVec3(Vec3{}),
+			m.TransformVec3(Vec3{X: 40}),
+			m.TransformVec3(Vec3{X: 40, Y: 30}),
+			m.TransformVec3(Vec3{Y: 30}),
+		}
+
+		for i, tri := range QuadTriangles(corners, camera) {
+			// Counter-clockwise from the camera: the normal points at it
+			normal := tri[1].Sub(tri[0]).Cross(tri[2].Sub(tri[0]))
+			if normal.Dot(camera.Sub(tri[0])) <= 0 {
+				t.Errorf("Rotation.Y %g: triangle %d %v is wound clockwise from the camera", yaw, i, tri)
+			}
+		}
+	}
+
+	// The two windings are the same triangles in opposite order
+	corners := [4]Vec3{{}, {X: 1}, {X: 1, Y: 1}, {Y: 1}}
+	front := QuadTriangles(corners, Vec3{Z: 5})
+	back := QuadTriangles(corners, Vec3{Z: -5})
+	if front != [2][3]Vec3{{corners[0], corners[1], corners[2]}, {corners[0], corners[2], corners[3]}} {
+		t.Errorf("QuadTriangles from +Z = %v, want the corner order", front)
+	}
+	if back != [2][3]Vec3{{corners[0], corners[2], corners[1]}, {corners[0], corners[3], corners[2]}} {
+		t.Errorf("QuadTriangles from -Z = %v, want the reverse order", back)
+	}
+}
+
+func TestCirclePoints(t *testing.T) {
+	center := Vec3{X: 5, Y: 2, Z: -3}
+	points := CirclePoints(center, 10, Vec3{}, 12)
+
+	if len(points) != 12 {
+		t.Fatalf("got %d points, want 12", len(points))
+	}
+	for i, p := range points {
+		if d := p.Sub(center).Length(); math.Abs(float64(d-10)) > 1e-3 {
+			t.Errorf("point %d is %f from center, want 10", i, d)
+		}
+		if p.Y != center.Y {
+			t.Errorf("point %d Y = %f, want %f on the XZ plane", i, p.Y, center.Y)
+		}
+	}
+
+	// Angle 0 is +X and the next point turns toward +Z
+	if !vec3Near(points[0], Vec3{X: 15, Y: 2, Z: -3}) {
+		t.Errorf("first point = %v, want {15 2 -3}", points[0])
+	}
+	if points[1].Z <= center.Z {
+		t.Errorf("second point Z = %f, want it to turn toward +Z", points[1].Z)
+	}
+
+	// The loop closes: the last point is one step short of the first
+	step := points[1].Sub(points[0]).Length()
+	if gap := points[0].Sub(points[11]).Length(); math.Abs(float64(gap-step)) > 1e-3 {
+		t.Errorf("closing segment length = %f, want %f", gap, step)
+	}
+
+	if got := len(CirclePoints(center, 1, Vec3{}, 1)); got != 3 {
+		t.Errorf("CirclePoints with 1 segment returned %d points, want 3", got)
+	}
+}
+
+func TestArcPoints(t *testing.T) {
+	points := ArcPoints(Vec3{}, 2, 0, 90, Vec3{Y: 1}, 4)
+
+	if len(points) != 5 {
+		t.Fatalf("got %d points, want 5", len(points))
+	}
+	if !vec3Near(points[0], Vec3{X: 2}) {
+		t.Errorf("start = %v, want {2 0 0}", points[0])
+	}
+	if !vec3Near(points[4], Vec3{Z: 2}) {
+		t.Errorf("end = %v, want {0 0 2}", points[4])
+	}
+
+	full := ArcPoints(Vec3{}, 1, 0, 360, Vec3{}, 8)
+	if !vec3Near(full[0], full[8]) {
+		t.Errorf("full arc does not close: %v != %v", full[0], full[8])
+	}
+}
+
+func TestArcPoints_Normal(t *testing.T) {
+	// A +Z normal puts the arc in the XY plane
+	normal := Vec3{Z: 1}
+	for i, p := range ArcPoints(Vec3{}, 3, 0, 180, normal, 6) {
+		if math.Abs(float64(p.Z)) > 1e-4 {
+			t.Errorf("point %d Z = %f, want 0", i, p.Z)
+		}
+		if d := p.Dot(normal); math.Abs(float64(d)) > 1e-4 {
+			t.Errorf("point %d not in plane, dot = %f", i, d)
+		}
+	}
+}
+
+func TestMakePolyXZ(t *testing.T) {
+	const radius = 12
+	poly := MakePolyXZ(6, radius, 0.25)
+	flat := MakePoly(6, radius, 0.25)
+
+	if len(poly) != 6 {
+		t.Fatalf("got %d vertices, want 6", len(poly))
+	}
+	for i, v := range poly {
+		if v.Y != 0 {
+			t.Errorf("vertex %d: Y = %f, want 0", i, v.Y)
+		}
+		if r := v.Length(); math.Abs(float64(r-radius)) > 1e-4 {
+			t.Errorf("vertex %d: radius = %f, want %d", i, r, radius)
+		}
+		// MakePoly's Y becomes Z
+		if v.X != flat[i].X || v.Z != flat[i].Y {
+			t.Errorf("vertex %d = %v, want MakePoly's %v moved onto XZ", i, v, flat[i])
+		}
+	}
+
+	// Angle 0 lies along +X and the next vertex turns toward +Z
+	square := MakePolyXZ(4, 1, 0)
+	if !vec3Near(square[0], Vec3{X: 1}) || !vec3Near(square[1], Vec3{Z: 1}) {
+		t.Errorf("square starts %v, %v, want {1 0 0}, {0 0 1}", square[0], square[1])
+	}
+}
+
+func TestFanTriangles(t *testing.T) {
+	tests := []struct {
+		n    int
+		want [][3]int
+	}{
+		{0, nil},
+		{2, nil},
+		{3, [][3]int{{0, 1, 2}}},
+		{5, [][3]int{{0, 1, 2}, {0, 2, 3}, {0, 3, 4}}},
+	}
+
+	for _, tt := range tests {
+		got := FanTriangles(tt.n)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("FanTriangles(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+
+	// The fan covers the polygon: its triangle areas sum to the hexagon's
+	hex := MakePolyXZ(6, 10, 0)
+	var area float32
+	for _, tri := range FanTriangles(len(hex)) {
+		area += hex[tri[1]].Sub(hex[tri[0]]).Cross(hex[tri[2]].Sub(hex[tri[0]])).Length() / 2
+	}
+	if want := float32(3 * math.Sqrt(3) / 2 * 100); math.Abs(float64(area-want)) > 1e-2 {
+		t.Errorf("fan area = %f, want %f", area, want)
+	}
+}
+
+func TestPolygonEdges(t *testing.T) {
+	tests := []struct {
+		n    int
+		want [][2]int
+	}{
+		{0, nil},
+		{1, nil},
+		{2, [][2]int{{0, 1}}},
+		{4, [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 0}}},
+	}
+
+	for _, tt := range tests {
+		if got := PolygonEdges(tt.n); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("PolygonEdges(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestDashIntervals(t *testing.T) {
+	tests := []struct {
+		name   string
+		length float32
+		offset float32
+		want   [][2]float32
+	}{
+		{"no offset", 20, 0, [][2]float32{{0, 5}, {8, 13}, {16, 20}}},
+		{"shifted", 20, 2, [][2]float32{{2, 7}, {10, 15}, {18, 20}}},
+		// The dash shifted off the start reappears clipped at the start
+		{"wrapped", 20, 7, [][2]float32{{0, 4}, {7, 12}, {15, 20}}},
+		{"full period", 20, 8, [][2]float32{{0, 5}, {8, 13}, {16, 20}}},
+		{"negative", 20, -3, [][2]float32{{0, 2}, {5, 10}, {13, 18}}},
+		{"empty line", 0, 2, nil},
+	}
+
+	for _, tt := range tests {
+		if got := DashIntervals(tt.length, 5, 3, tt.offset); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: DashIntervals = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestClipSegmentToRect(t *testing.T) {
+	// Rect from (0, 0) to (10, 20)
+	tests := []struct {
+		name         string
+		a, b         Vec2
+		wantA, wantB Vec2
+		wantOK       bool
+	}{
+		{"inside", Vec2{X: 1, Y: 2}, Vec2{X: 9, Y: 18}, Vec2{X: 1, Y: 2}, Vec2{X: 9, Y: 18}, true},
+		{"on edge", Vec2{X: 0, Y: 5}, Vec2{X: 0, Y: 15}, Vec2{X: 0, Y: 5}, Vec2{X: 0, Y: 15}, true},
+		{"outside", Vec2{X: 11, Y: 2}, Vec2{X: 15, Y: 18}, Vec2{}, Vec2{}, false},
+		{"parallel outside", Vec2{X: -5, Y: 25}, Vec2{X: 15, Y: 25}, Vec2{}, Vec2{}, false},
+		{"past corner", Vec2{X: 6, Y: 25}, Vec2{X: 15, Y: 16}, Vec2{}, Vec2{}, false},
+		{"straddling top", Vec2{X: 5, Y: 10}, Vec2{X: 5, Y: 30}, Vec2{X: 5, Y: 10}, Vec2{X: 5, Y: 20}, true},
+		{"straddling left", Vec2{X: -10, Y: 4}, Vec2{X: 10, Y: 14}, Vec2{X: 0, Y: 9}, Vec2{X: 10, Y: 14}, true},
+		{"through", Vec2{X: -5, Y: 10}, Vec2{X: 15, Y: 10}, Vec2{X: 0, Y: 10}, Vec2{X: 10, Y: 10}, true},
+		{"diagonal through", Vec2{X: 15, Y: 25}, Vec2{X: -5, Y: 5}, Vec2{X: 10, Y: 20}, Vec2{X: 0, Y: 10}, true},
+	}
+
+	for _, tt := range tests {
+		a, b, ok := ClipSegmentToRect(tt.a, tt.b, 0, 0, 10, 20)
+		if ok != tt.wantOK {
+			t.Errorf("%s: ok = %v, want %v", tt.name, ok, tt.wantOK)
+			continue
+		}
+		if ok && (!vec2Near(a, tt.wantA) || !vec2Near(b, tt.wantB)) {
+			t.Errorf("%s: ClipSegmentToRect = %v, %v, want %v, %v", tt.name, a, b, tt.wantA, tt.wantB)
+		}
+	}
+}
+
+func TestDashSegments(t *testing.T) {
+	// 20 long along the 3-4-5 diagonal: dashes at [0, 5], [8, 13], [16, 20]
+	got := DashSegments(Vec3{X: 1}, Vec3{X: 13, Y: 16}, 5, 3, 0)
+	want := [][2]Vec3{
+		{{X: 1}, {X: 4, Y: 4}},
+		{{X: 5.8, Y: 6.4}, {X: 8.8, Y: 10.4}},
+		{{X: 10.6, Y: 12.8}, {X: 13, Y: 16}},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("DashSegments = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !vec3Near(got[i][0], want[i][0]) || !vec3Near(got[i][1], want[i][1]) {
+			t.Errorf("dash %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if got := DashSegments(Vec3{X: 1}, Vec3{X: 1}, 5, 3, 0); got != nil {
+		t.Errorf("zero-length DashSegments = %v, want nil", got)
+	}
+}
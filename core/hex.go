@@ -23,7 +23,8 @@ const (
 )
 
 // hexDirectionVectors maps each direction to its axial coordinate offset.
-// These are for "pointy-top" hex orientation.
+// The names match the pointy-top orientation; on a flat-top layout each
+// direction points 30° clockwise of its name (E is down-right, NW is up).
 var hexDirectionVectors = [6]HexCoord{
 	{Q: +1, R: 0},  // E
 	{Q: +1, R: -1}, // NE
@@ -107,35 +108,65 @@ func (c HexCubeCoord) ToAxial() HexCoord {
 	return HexCoord{Q: c.Q, R: c.R}
 }
 
+// HexOrientation selects how hexes sit on screen.
+type HexOrientation int
+
+const (
+	HexPointyTop HexOrientation = iota // Vertex at the top, flat sides left and right
+	HexFlatTop                         // Flat side at the top, vertices left and right
+)
+
 // HexLayout defines the orientation and size for converting hex to pixel coordinates.
+// The zero Orientation is HexPointyTop.
 type HexLayout struct {
-	Size   Vec2 // Size of each hex (width/2 and height/2 for pointy-top)
-	Origin Vec2 // Pixel coordinate of hex (0, 0)
+	Size        Vec2           // Size of each hex (width/2 and height/2 for pointy-top)
+	Origin      Vec2           // Pixel coordinate of hex (0, 0)
+	Orientation HexOrientation // Pointy-top or flat-top
+
+	// VertexAngleOffset turns every hex's vertices this many degrees
+	// counter-clockwise on screen about its center, for a visually rotated
+	// tiling. Centers, and so ToPixel, FromPixel and the cell under a
+	// point, are unaffected.
+	VertexAngleOffset float32
 }
 
-// NewHexLayout creates a new hex layout with the given size and origin.
+// NewHexLayout creates a new pointy-top hex layout with the given size and origin.
 func NewHexLayout(size, origin Vec2) HexLayout {
 	return HexLayout{Size: size, Origin: origin}
 }
 
+// NewFlatHexLayout creates a new flat-top hex layout with the given size and origin.
+func NewFlatHexLayout(size, origin Vec2) HexLayout {
+	return HexLayout{Size: size, Origin: origin, Orientation: HexFlatTop}
+}
+
 // ToPixel converts a hex coordinate to pixel coordinates (center of hex).
-// Uses pointy-top orientation.
 func (l HexLayout) ToPixel(h HexCoord) Vec2 {
-	// Pointy-top orientation matrix
-	x := l.Size.X * (sqrt3*float32(h.Q) + sqrt3/2*float32(h.R))
-	y := l.Size.Y * (3.0 / 2.0 * float32(h.R))
+	var x, y float32
+	if l.Orientation == HexFlatTop {
+		x = l.Size.X * (3.0 / 2.0 * float32(h.Q))
+		y = l.Size.Y * (sqrt3/2*float32(h.Q) + sqrt3*float32(h.R))
+	} else {
+		x = l.Size.X * (sqrt3*float32(h.Q) + sqrt3/2*float32(h.R))
+		y = l.Size.Y * (3.0 / 2.0 * float32(h.R))
+	}
 	return Vec2{X: x + l.Origin.X, Y: y + l.Origin.Y}
 }
 
 // FromPixel converts pixel coordinates to the nearest hex coordinate.
-// Uses pointy-top orientation.
 func (l HexLayout) FromPixel(p Vec2) HexCoord {
-	// Inverse of pointy-top orientation matrix
+	// Inverse of the orientation matrix used by ToPixel
 	px := (p.X - l.Origin.X) / l.Size.X
 	py := (p.Y - l.Origin.Y) / l.Size.Y
 
-	q := sqrt3/3*px - 1.0/3*py
-	r := 2.0 / 3 * py
+	var q, r float32
+	if l.Orientation == HexFlatTop {
+		q = 2.0 / 3 * px
+		r = -1.0/3*px + sqrt3/3*py
+	} else {
+		q = sqrt3/3*px - 1.0/3*py
+		r = 2.0 / 3 * py
+	}
 
 	return hexRound(float64(q), float64(r))
 }
@@ -152,10 +183,11 @@ func hexRound(q, r float64) HexCoord {
 	rDiff := math.Abs(rr - r)
 	sDiff := math.Abs(rs - s)
 
-	// Reset the component with the largest difference
-	if qDiff > rDiff && qDiff > sDiff {
+	// Reset the component with the largest difference. Ties go to q, then
+	// r, so a point exactly on an edge always rounds the same way.
+	if qDiff >= rDiff && qDiff >= sDiff {
 		rq = -rr - rs
-	} else if rDiff > sDiff {
+	} else if rDiff >= sDiff {
 		rr = -rq - rs
 	}
 
@@ -163,22 +195,39 @@ func hexRound(q, r float64) HexCoord {
 }
 
 // HexRing returns all hex coordinates at exactly the given radius from center.
+// The walk starts at the SW corner and runs counter-clockwise.
 func HexRing(center HexCoord, radius int) []HexCoord {
+	return HexRingFrom(center, radius, HexDirSW, false)
+}
+
+// HexRingFrom returns all hex coordinates at exactly the given radius from
+// center, starting at the corner radius steps from center in startDir and
+// walking around the ring clockwise or counter-clockwise (as seen with
+// north up).
+func HexRingFrom(center HexCoord, radius int, startDir HexDirection, clockwise bool) []HexCoord {
 	if radius <= 0 {
 		return []HexCoord{center}
 	}
 
 	results := make([]HexCoord, 0, 6*radius)
 
-	// Start at the hex radius steps in the SW direction
-	hex := center.Add(hexDirectionVectors[HexDirSW].Scale(radius))
+	// Start at the corner radius steps away in startDir
+	hex := center.Add(hexDirectionVectors[startDir].Scale(radius))
+
+	// Each side runs two directions on from the corner's direction:
+	// increasing indices turn counter-clockwise, decreasing clockwise
+	dir, turn := (startDir+2)%6, HexDirection(1)
+	if clockwise {
+		dir, turn = (startDir+4)%6, 5
+	}
 
 	// Walk around the ring
 	for i := 0; i < 6; i++ {
 		for j := 0; j < radius; j++ {
 			results = append(results, hex)
-			hex = hex.Neighbor(HexDirection(i))
+			hex = hex.Neighbor(dir)
 		}
+		dir = (dir + turn) % 6
 	}
 
 	return results
@@ -186,16 +235,120 @@ func HexRing(center HexCoord, radius int) []HexCoord {
 
 // HexSpiral returns all hex coordinates within the given radius, starting from center.
 func HexSpiral(center HexCoord, radius int) []HexCoord {
+	return HexSpiralFrom(center, radius, HexDirSW, false)
+}
+
+// HexSpiralFrom returns all hex coordinates within the given radius, from
+// center outward ring by ring. Each ring is walked as HexRingFrom does.
+func HexSpiralFrom(center HexCoord, radius int, startDir HexDirection, clockwise bool) []HexCoord {
 	results := []HexCoord{center}
 
 	for r := 1; r <= radius; r++ {
-		results = append(results, HexRing(center, r)...)
+		results = append(results, HexRingFrom(center, r, startDir, clockwise)...)
+	}
+
+	return results
+}
+
+// HexSpiralInward returns all hex coordinates within the given radius, from
+// the outer ring inward and ending at center. Each ring is walked as
+// HexRingFrom does.
+func HexSpiralInward(center HexCoord, radius int, startDir HexDirection, clockwise bool) []HexCoord {
+	results := make([]HexCoord, 0, 3*radius*radius+3*radius+1)
+
+	for r := radius; r >= 1; r-- {
+		results = append(results, HexRingFrom(center, r, startDir, clockwise)...)
+	}
+
+	return append(results, center)
+}
+
+// HexCone returns the cells within radius of center whose direction from
+// center lies in a wedge centered on dir, in HexSpiral order. width is the
+// wedge's angular spread in 60° steps: 0 gives the straight line of cells
+// toward dir, 2 adds the directions either side of it, and 6 or more gives
+// the whole HexSpiral. Cells on the wedge's edges are included. center is
+// always included.
+func HexCone(center HexCoord, dir HexDirection, radius int, width int) []HexCoord {
+	if width >= 6 {
+		return HexSpiral(center, radius)
 	}
 
+	halfAngle := float64(width) * math.Pi / 6
+	axis := float64(dir) * math.Pi / 3
+
+	results := []HexCoord{center}
+	for _, coord := range HexSpiral(center, radius)[1:] {
+		// Angle of the offset counter-clockwise from E, in the pointy-top
+		// layout with north up
+		d := coord.Sub(center)
+		x := math.Sqrt(3) * (float64(d.Q) + float64(d.R)/2)
+		y := -1.5 * float64(d.R)
+		diff := math.Remainder(math.Atan2(y, x)-axis, 2*math.Pi)
+		if math.Abs(diff) <= halfAngle+1e-9 {
+			results = append(results, coord)
+		}
+	}
+
+	return results
+}
+
+// HexSetUnion returns the cells in a or b: those of a followed by those of
+// b not in a, each once.
+func HexSetUnion(a, b []HexCoord) []HexCoord {
+	seen := make(map[HexCoord]bool, len(a)+len(b))
+	results := make([]HexCoord, 0, len(a)+len(b))
+	for _, list := range [2][]HexCoord{a, b} {
+		for _, h := range list {
+			if !seen[h] {
+				seen[h] = true
+				results = append(results, h)
+			}
+		}
+	}
+	return results
+}
+
+// HexSetIntersect returns the cells of a that are also in b, in a's order,
+// each once.
+func HexSetIntersect(a, b []HexCoord) []HexCoord {
+	return hexSetFilter(a, b, true)
+}
+
+// HexSetDiff returns the cells of a that are not in b, in a's order, each
+// once.
+func HexSetDiff(a, b []HexCoord) []HexCoord {
+	return hexSetFilter(a, b, false)
+}
+
+// hexSetFilter returns the cells of a whose membership in b matches inB,
+// dropping repeats.
+func hexSetFilter(a, b []HexCoord, inB bool) []HexCoord {
+	members := make(map[HexCoord]bool, len(b))
+	for _, h := range b {
+		members[h] = true
+	}
+
+	seen := make(map[HexCoord]bool, len(a))
+	results := make([]HexCoord, 0, len(a))
+	for _, h := range a {
+		if members[h] == inB && !seen[h] {
+			seen[h] = true
+			results = append(results, h)
+		}
+	}
 	return results
 }
 
+// Offsets applied to HexLine samples to keep them off hex edges. S is
+// implicitly nudged by -(Q+R), so all three cube components differ.
+const (
+	hexLineNudgeQ = 1e-6
+	hexLineNudgeR = 2e-6
+)
+
 // HexLine returns the hex coordinates on a line between two hexes.
+// HexLine(b, a) is HexLine(a, b) reversed.
 func HexLine(a, b HexCoord) []HexCoord {
 	n := a.Distance(b)
 	if n == 0 {
@@ -206,8 +359,11 @@ func HexLine(a, b HexCoord) []HexCoord {
 
 	for i := 0; i <= n; i++ {
 		t := float64(i) / float64(n)
-		q := lerp(float64(a.Q), float64(b.Q), t)
-		r := lerp(float64(a.R), float64(b.R), t)
+		// Nudge every sample by the same tiny, unequal amounts so none sits
+		// exactly on an edge or corner. The line then rounds the same way
+		// whichever end it is drawn from.
+		q := lerp(float64(a.Q), float64(b.Q), t) + hexLineNudgeQ
+		r := lerp(float64(a.R), float64(b.R), t) + hexLineNudgeR
 		results[i] = hexRound(q, r)
 	}
 
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -215,6 +216,26 @@ func TestHexLayout_FromPixel_Roundtrip(t *testing.T) {
 	}
 }
 
+func TestHexLayout_FlatTop(t *testing.T) {
+	layout := NewFlatHexLayout(Vec2{X: 20, Y: 20}, Vec2{X: 100, Y: 100})
+
+	// East is down-right and northwest is straight up on a flat-top layout
+	east := layout.ToPixel(HexCoord{Q: 1, R: 0})
+	if east.X != 130 || east.Y <= 100 {
+		t.Errorf("ToPixel(E) = %v, want X 130 and below the origin", east)
+	}
+	nw := layout.ToPixel(HexCoord{Q: 0, R: -1})
+	if nw.X != 100 || nw.Y >= 100 {
+		t.Errorf("ToPixel(NW) = %v, want X 100 and above the origin", nw)
+	}
+
+	for _, h := range HexSpiral(HexCoord{Q: 0, R: 0}, 3) {
+		if got := layout.FromPixel(layout.ToPixel(h)); got != h {
+			t.Errorf("Roundtrip failed: %v -> %v", h, got)
+		}
+	}
+}
+
 func TestHexRing(t *testing.T) {
 	center := HexCoord{Q: 0, R: 0}
 
@@ -273,6 +294,205 @@ func TestHexSpiral(t *testing.T) {
 	}
 }
 
+func TestHexRingFrom(t *testing.T) {
+	center := HexCoord{Q: 0, R: 0}
+
+	tests := []struct {
+		name      string
+		startDir  HexDirection
+		clockwise bool
+		want      []HexCoord
+	}{
+		{
+			name:     "default matches HexRing",
+			startDir: HexDirSW,
+			want:     []HexCoord{{-1, 1}, {0, 1}, {1, 0}, {1, -1}, {0, -1}, {-1, 0}},
+		},
+		{
+			name:      "clockwise from SW",
+			startDir:  HexDirSW,
+			clockwise: true,
+			want:      []HexCoord{{-1, 1}, {-1, 0}, {0, -1}, {1, -1}, {1, 0}, {0, 1}},
+		},
+		{
+			name:     "counter-clockwise from E",
+			startDir: HexDirE,
+			want:     []HexCoord{{1, 0}, {1, -1}, {0, -1}, {-1, 0}, {-1, 1}, {0, 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := HexRingFrom(center, 1, tt.startDir, tt.clockwise)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d hexes, want %d", len(got), len(tt.want))
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("got %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+
+	if ring := HexRing(center, 1); ring[0] != (HexCoord{-1, 1}) || ring[1] != (HexCoord{0, 1}) {
+		t.Errorf("HexRing order changed: %v", ring)
+	}
+
+	// Every variant of a larger ring is a closed walk of adjacent hexes
+	for dir := HexDirE; dir <= HexDirSE; dir++ {
+		for _, clockwise := range []bool{false, true} {
+			ring := HexRingFrom(center, 3, dir, clockwise)
+			if len(ring) != 18 {
+				t.Fatalf("HexRingFrom(%d, %v) has %d hexes, want 18", dir, clockwise, len(ring))
+			}
+			for i := range ring {
+				if ring[i].Distance(center) != 3 || ring[i].Distance(ring[(i+1)%18]) != 1 {
+					t.Errorf("HexRingFrom(%d, %v) breaks at %v", dir, clockwise, ring[i])
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestHexSpiralInward(t *testing.T) {
+	center := HexCoord{Q: 2, R: -1}
+	spiral := HexSpiralInward(center, 3, HexDirE, true)
+
+	if len(spiral) != 37 {
+		t.Fatalf("HexSpiralInward has %d hexes, want 37", len(spiral))
+	}
+	if spiral[0].Distance(center) != 3 {
+		t.Errorf("first hex %v is at distance %d, want 3", spiral[0], spiral[0].Distance(center))
+	}
+	if spiral[len(spiral)-1] != center {
+		t.Errorf("last hex = %v, want center", spiral[len(spiral)-1])
+	}
+
+	// Distances never increase, and every cell appears exactly once
+	seen := make(map[HexCoord]bool)
+	for i, h := range spiral {
+		if seen[h] {
+			t.Errorf("hex %v visited twice", h)
+		}
+		seen[h] = true
+		if i > 0 && h.Distance(center) > spiral[i-1].Distance(center) {
+			t.Errorf("spiral moved outward at %v", h)
+		}
+	}
+
+	outward := HexSpiralFrom(center, 3, HexDirE, true)
+	for _, h := range outward {
+		if !seen[h] {
+			t.Errorf("outward spiral hex %v missing from inward spiral", h)
+		}
+	}
+}
+
+func TestHexCone(t *testing.T) {
+	center := HexCoord{Q: 1, R: 2}
+
+	// A full-width cone is the whole spiral
+	full := HexCone(center, HexDirNE, 3, 6)
+	spiral := HexSpiral(center, 3)
+	if len(full) != len(spiral) {
+		t.Fatalf("full cone has %d hexes, want %d", len(full), len(spiral))
+	}
+	for i := range spiral {
+		if full[i] != spiral[i] {
+			t.Errorf("full cone[%d] = %v, want %v", i, full[i], spiral[i])
+		}
+	}
+
+	// A zero-width cone is the straight line toward dir
+	for dir := HexDirection(0); dir < 6; dir++ {
+		line := HexCone(center, dir, 3, 0)
+		if len(line) != 4 {
+			t.Errorf("dir %v: narrow cone has %d hexes, want 4: %v", dir, len(line), line)
+			continue
+		}
+		for i, h := range line {
+			if want := center.Add(hexDirectionVectors[dir].Scale(i)); h != want {
+				t.Errorf("dir %v: narrow cone[%d] = %v, want %v", dir, i, h, want)
+			}
+		}
+	}
+
+	// A 120° cone takes the three neighbors facing dir, and at radius 2
+	// the five cells of the ring between them
+	cone := HexCone(center, HexDirE, 2, 2)
+	if len(cone) != 1+3+5 {
+		t.Errorf("120° cone has %d hexes, want 9: %v", len(cone), cone)
+	}
+	for _, h := range cone {
+		for _, dir := range []HexDirection{HexDirNW, HexDirW, HexDirSW} {
+			if h == center.Neighbor(dir) {
+				t.Errorf("120° cone toward E includes the %v neighbor %v", dir, h)
+			}
+		}
+	}
+}
+
+func TestHexSetOperations(t *testing.T) {
+	a, b, c, d := HexCoord{Q: 0, R: 0}, HexCoord{Q: 1, R: 0}, HexCoord{Q: 2, R: 0}, HexCoord{Q: 3, R: 0}
+
+	tests := []struct {
+		name                   string
+		x, y                   []HexCoord
+		union, intersect, diff []HexCoord
+	}{
+		{
+			name: "overlap",
+			x:    []HexCoord{a, b, c}, y: []HexCoord{c, b, d},
+			union:     []HexCoord{a, b, c, d},
+			intersect: []HexCoord{b, c},
+			diff:      []HexCoord{a},
+		},
+		{
+			name: "disjoint",
+			x:    []HexCoord{a, b}, y: []HexCoord{c, d},
+			union:     []HexCoord{a, b, c, d},
+			intersect: []HexCoord{},
+			diff:      []HexCoord{a, b},
+		},
+		{
+			name: "duplicates",
+			x:    []HexCoord{b, a, b, a}, y: []HexCoord{a, a, c, c},
+			union:     []HexCoord{b, a, c},
+			intersect: []HexCoord{a},
+			diff:      []HexCoord{b},
+		},
+		{
+			name: "empty",
+			x:    nil, y: []HexCoord{a},
+			union:     []HexCoord{a},
+			intersect: []HexCoord{},
+			diff:      []HexCoord{},
+		},
+	}
+
+	for _, tt := range tests {
+		if got := HexSetUnion(tt.x, tt.y); !reflect.DeepEqual(got, tt.union) {
+			t.Errorf("%s: HexSetUnion = %v, want %v", tt.name, got, tt.union)
+		}
+		if got := HexSetIntersect(tt.x, tt.y); !reflect.DeepEqual(got, tt.intersect) {
+			t.Errorf("%s: HexSetIntersect = %v, want %v", tt.name, got, tt.intersect)
+		}
+		if got := HexSetDiff(tt.x, tt.y); !reflect.DeepEqual(got, tt.diff) {
+			t.Errorf("%s: HexSetDiff = %v, want %v", tt.name, got, tt.diff)
+		}
+	}
+
+	// Shapes compose: a radius-2 ring minus a cone leaves the rest of it
+	ring := HexRing(HexCoord{}, 2)
+	rest := HexSetDiff(ring, HexCone(HexCoord{}, HexDirE, 2, 2))
+	if len(rest) != 12-5 {
+		t.Errorf("ring minus cone has %d hexes, want 7: %v", len(rest), rest)
+	}
+}
+
 func TestHexLine(t *testing.T) {
 	// Line from origin to self
 	origin := HexCoord{Q: 0, R: 0}
@@ -304,6 +524,80 @@ func TestHexLine(t *testing.T) {
 	}
 }
 
+func TestHexLineEdgeMidpoints(t *testing.T) {
+	// These lines pass exactly through edge midpoints between two hexes,
+	// where an un-nudged sample would be a rounding tie.
+	tests := []struct {
+		a, b HexCoord
+	}{
+		{HexCoord{Q: 0, R: 0}, HexCoord{Q: 1, R: 1}},
+		{HexCoord{Q: 0, R: 0}, HexCoord{Q: 2, R: -1}},
+		{HexCoord{Q: 0, R: 0}, HexCoord{Q: -1, R: 2}},
+		{HexCoord{Q: 0, R: 0}, HexCoord{Q: 3, R: 3}},
+		{HexCoord{Q: -2, R: 1}, HexCoord{Q: 2, R: -1}},
+	}
+
+	for _, tt := range tests {
+		line := HexLine(tt.a, tt.b)
+		want := tt.a.Distance(tt.b) + 1
+		if len(line) != want {
+			t.Errorf("HexLine(%v, %v) has %d hexes, want %d", tt.a, tt.b, len(line), want)
+			continue
+		}
+		if line[0] != tt.a || line[len(line)-1] != tt.b {
+			t.Errorf("HexLine(%v, %v) endpoints = %v, %v", tt.a, tt.b, line[0], line[len(line)-1])
+		}
+		for i := 1; i < len(line); i++ {
+			if d := line[i-1].Distance(line[i]); d != 1 {
+				t.Errorf("HexLine(%v, %v) step %d: distance = %d, want 1", tt.a, tt.b, i, d)
+			}
+		}
+	}
+}
+
+func TestHexLineSymmetric(t *testing.T) {
+	coords := HexSpiral(HexCoord{Q: 0, R: 0}, 4)
+	for _, a := range coords {
+		for _, b := range coords {
+			fwd := HexLine(a, b)
+			rev := HexLine(b, a)
+			if len(fwd) != len(rev) {
+				t.Fatalf("HexLine(%v, %v) length %d, reversed %d", a, b, len(fwd), len(rev))
+			}
+			for i := range fwd {
+				if fwd[i] != rev[len(rev)-1-i] {
+					t.Errorf("HexLine(%v, %v) is not the reverse of HexLine(%v, %v)", a, b, b, a)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestHexRoundTies(t *testing.T) {
+	// Points exactly halfway between two cells, tied on each pair of cube
+	// axes. The tied component nearest the front of q, r, s is reset, so
+	// each rounds to one particular cell of the two.
+	tests := []struct {
+		name string
+		q, r float64
+		want HexCoord
+	}{
+		{"q-r between (1, 0) and (0, 1)", 0.5, 0.5, HexCoord{Q: 0, R: 1}},
+		{"q-r between (-1, 0) and (0, -1)", -0.5, -0.5, HexCoord{Q: 0, R: -1}},
+		{"q-s between (0, 0) and (1, 0)", 0.5, 0, HexCoord{Q: 1, R: 0}},
+		{"q-s between (0, 0) and (-1, 0)", -0.5, 0, HexCoord{Q: -1, R: 0}},
+		{"r-s between (0, 0) and (0, 1)", 0, 0.5, HexCoord{Q: 0, R: 1}},
+		{"r-s between (0, 0) and (0, -1)", 0, -0.5, HexCoord{Q: 0, R: -1}},
+	}
+
+	for _, tt := range tests {
+		if got := hexRound(tt.q, tt.r); got != tt.want {
+			t.Errorf("%s: hexRound(%v, %v) = %v, want %v", tt.name, tt.q, tt.r, got, tt.want)
+		}
+	}
+}
+
 func TestDirectionVector(t *testing.T) {
 	// Verify each direction vector has length 1
 	for dir := HexDirE; dir <= HexDirSE; dir++ {
@@ -11,6 +11,9 @@ package core
 // - Radius 2: 19 cells
 // - Radius 3: 37 cells
 // - Radius 4: 61 cells
+//
+// HexGrid is not safe for concurrent use. Use SyncHexGrid when one
+// goroutine writes while others read.
 type HexGrid[T any] struct {
 	radius int
 	data   map[HexCoord]T
@@ -154,6 +157,62 @@ func (g *HexGrid[T]) Neighbors(coord HexCoord) []HexCoord {
 	return result
 }
 
+// HexNeighbor is an adjacent cell along with the direction taken to reach it.
+type HexNeighbor struct {
+	Dir   HexDirection
+	Coord HexCoord
+}
+
+// NeighborsWithDir returns the valid neighbors of the given coordinate
+// paired with their directions, in order from HexDirE to HexDirSE.
+func (g *HexGrid[T]) NeighborsWithDir(coord HexCoord) []HexNeighbor {
+	result := make([]HexNeighbor, 0, 6)
+	for dir := HexDirE; dir <= HexDirSE; dir++ {
+		if n := coord.Neighbor(dir); g.IsValid(n) {
+			result = append(result, HexNeighbor{Dir: dir, Coord: n})
+		}
+	}
+	return result
+}
+
+// LineTo returns the cells of HexLine(a, b) up to, but not including, the
+// first one outside the grid, so a line leaving the grid stops at its edge.
+// Returns an empty slice if a itself is outside the grid.
+func (g *HexGrid[T]) LineTo(a, b HexCoord) []HexCoord {
+	line := HexLine(a, b)
+	for i, coord := range line {
+		if !g.IsValid(coord) {
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// FloodFill returns every cell connected to start, found by a breadth-first
+// walk over valid neighbors. A neighbor to is entered from from only when
+// match(from, to) returns true. start is always included, first; the rest
+// follow in the order they were reached. Returns nil if start is invalid.
+func (g *HexGrid[T]) FloodFill(start HexCoord, match func(from, to HexCoord) bool) []HexCoord {
+	if !g.IsValid(start) {
+		return nil
+	}
+
+	visited := map[HexCoord]bool{start: true}
+	result := []HexCoord{start}
+
+	for i := 0; i < len(result); i++ {
+		from := result[i]
+		for _, to := range g.Neighbors(from) {
+			if visited[to] || !match(from, to) {
+				continue
+			}
+			visited[to] = true
+			result = append(result, to)
+		}
+	}
+	return result
+}
+
 // Fill sets all valid coordinates to the given value.
 func (g *HexGrid[T]) Fill(value T) {
 	for _, coord := range g.All() {
@@ -172,3 +231,104 @@ func (g *HexGrid[T]) Clone() *HexGrid[T] {
 	}
 	return clone
 }
+
+// Translated returns a new grid with every set cell moved by offset. Its
+// radius is grown by offset's length, so every moved cell still fits.
+func (g *HexGrid[T]) Translated(offset HexCoord) *HexGrid[T] {
+	translated := NewHexGrid[T](g.radius + offset.Length())
+	translated.Blit(g, offset)
+	return translated
+}
+
+// Blit copies src's set cells into g, each moved by offset, overwriting
+// what g holds there, as when placing a chunk into a larger map. Cells
+// that land outside g are skipped. Returns the number of cells copied.
+// A grid may be blitted onto itself to shift its contents.
+func (g *HexGrid[T]) Blit(src *HexGrid[T], offset HexCoord) int {
+	if src == g {
+		// Read from a snapshot so cells written by this pass aren't
+		// copied again
+		src = src.Clone()
+	}
+	copied := 0
+	for coord, value := range src.data {
+		if g.Set(coord.Add(offset), value) {
+			copied++
+		}
+	}
+	return copied
+}
+
+// EqualHexGrid reports whether two grids have the same radius and the same
+// coordinates set to equal values. Two nil grids are equal.
+func EqualHexGrid[T comparable](a, b *HexGrid[T]) bool {
+	return EqualHexGridFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+// EqualHexGridFunc is like EqualHexGrid but compares values with eq, for
+// value types that are not comparable.
+func EqualHexGridFunc[T any](a, b *HexGrid[T], eq func(x, y T) bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.radius != b.radius || len(a.data) != len(b.data) {
+		return false
+	}
+	for coord, av := range a.data {
+		bv, ok := b.data[coord]
+		if !ok || !eq(av, bv) {
+			return false
+		}
+	}
+	return true
+}
+
+// HexCellChange is a cell that differs between two grids: its value in the
+// newer grid, or Removed if the newer grid no longer sets it.
+type HexCellChange[T any] struct {
+	Coord   HexCoord
+	Value   T
+	Removed bool
+}
+
+// DiffHexGrid returns the coordinates whose values differ from one grid to
+// the next, including cells set in only one of them, in spiral order. A
+// nil grid counts as empty. Pair it with Clone to send only what changed
+// since the last snapshot.
+func DiffHexGrid[T comparable](from, to *HexGrid[T]) []HexCoord {
+	changes := DiffHexGridValues(from, to)
+	if len(changes) == 0 {
+		return nil
+	}
+	coords := make([]HexCoord, len(changes))
+	for i, change := range changes {
+		coords[i] = change.Coord
+	}
+	return coords
+}
+
+// DiffHexGridValues is like DiffHexGrid but also returns each changed
+// cell's new value, so applying the changes to from reproduces to.
+func DiffHexGridValues[T comparable](from, to *HexGrid[T]) []HexCellChange[T] {
+	var fromData, toData map[HexCoord]T
+	radius := 0
+	if from != nil {
+		fromData, radius = from.data, from.radius
+	}
+	if to != nil {
+		toData, radius = to.data, max(radius, to.radius)
+	}
+
+	var changes []HexCellChange[T]
+	for _, coord := range HexSpiral(HexCoord{}, radius) {
+		fv, inFrom := fromData[coord]
+		tv, inTo := toData[coord]
+		switch {
+		case inTo && (!inFrom || fv != tv):
+			changes = append(changes, HexCellChange[T]{Coord: coord, Value: tv})
+		case inFrom && !inTo:
+			changes = append(changes, HexCellChange[T]{Coord: coord, Removed: true})
+		}
+	}
+	return changes
+}
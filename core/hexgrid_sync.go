@@ -0,0 +1,114 @@
+// Package core provides concurrency-safe hex grid storage for the Spectrex framework.
+package core
+
+import "sync"
+
+// SyncHexGrid wraps a HexGrid with a read-write mutex so it can be updated
+// from one goroutine while others read it, e.g. a simulation worker and the
+// render loop. Iteration methods snapshot the cells under the lock and run
+// the callback after releasing it, so callbacks may call back into the grid.
+type SyncHexGrid[T any] struct {
+	mu   sync.RWMutex
+	grid *HexGrid[T]
+}
+
+// NewSyncHexGrid creates a new concurrency-safe hex grid with the given radius.
+func NewSyncHexGrid[T any](radius int) *SyncHexGrid[T] {
+	return &SyncHexGrid[T]{grid: NewHexGrid[T](radius)}
+}
+
+// Radius returns the radius of the grid.
+func (g *SyncHexGrid[T]) Radius() int {
+	return g.grid.Radius()
+}
+
+// Size returns the total number of valid cells in the grid.
+func (g *SyncHexGrid[T]) Size() int {
+	return g.grid.Size()
+}
+
+// IsValid returns true if the coordinate is within the grid's radius.
+func (g *SyncHexGrid[T]) IsValid(coord HexCoord) bool {
+	return g.grid.IsValid(coord)
+}
+
+// Get returns the value at the given coordinate.
+// Returns the zero value if the coordinate is invalid or not set.
+func (g *SyncHexGrid[T]) Get(coord HexCoord) T {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.grid.Get(coord)
+}
+
+// GetOk returns the value at the given coordinate and whether it was found.
+func (g *SyncHexGrid[T]) GetOk(coord HexCoord) (T, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.grid.GetOk(coord)
+}
+
+// Set stores a value at the given coordinate.
+// Returns false if the coordinate is outside the grid's radius.
+func (g *SyncHexGrid[T]) Set(coord HexCoord, value T) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.grid.Set(coord, value)
+}
+
+// Delete removes the value at the given coordinate.
+// Returns false if the coordinate is outside the grid's radius.
+func (g *SyncHexGrid[T]) Delete(coord HexCoord) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.grid.Delete(coord)
+}
+
+// Clear removes all values from the grid.
+func (g *SyncHexGrid[T]) Clear() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.grid.Clear()
+}
+
+// Count returns the number of cells that have been set.
+func (g *SyncHexGrid[T]) Count() int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.grid.Count()
+}
+
+// Fill sets all valid coordinates to the given value.
+func (g *SyncHexGrid[T]) Fill(value T) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.grid.Fill(value)
+}
+
+// Update runs fn with exclusive access to the underlying grid, for batches
+// of changes that should appear to readers all at once. fn must not keep
+// the grid after it returns.
+func (g *SyncHexGrid[T]) Update(fn func(grid *HexGrid[T])) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	fn(g.grid)
+}
+
+// Snapshot returns a copy of the grid as it is now. The copy is a plain
+// HexGrid owned by the caller.
+func (g *SyncHexGrid[T]) Snapshot() *HexGrid[T] {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.grid.Clone()
+}
+
+// ForEach calls the function for each valid coordinate in the grid, in
+// spiral order, with the values as they were when iteration started.
+func (g *SyncHexGrid[T]) ForEach(fn func(coord HexCoord, value T)) {
+	g.Snapshot().ForEach(fn)
+}
+
+// ForEachSet calls the function for each coordinate that had a value set
+// when iteration started. Order is not guaranteed.
+func (g *SyncHexGrid[T]) ForEachSet(fn func(coord HexCoord, value T)) {
+	g.Snapshot().ForEachSet(fn)
+}
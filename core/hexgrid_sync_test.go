@@ -0,0 +1,87 @@
+package core
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSyncHexGrid_Basic(t *testing.T) {
+	g := NewSyncHexGrid[int](2)
+
+	if !g.Set(HexCoord{Q: 1, R: 0}, 5) {
+		t.Fatal("Set on a valid coord returned false")
+	}
+	if g.Set(HexCoord{Q: 3, R: 0}, 5) {
+		t.Error("Set outside the radius returned true")
+	}
+	if v, ok := g.GetOk(HexCoord{Q: 1, R: 0}); !ok || v != 5 {
+		t.Errorf("GetOk = (%d, %v), want (5, true)", v, ok)
+	}
+	if g.Count() != 1 {
+		t.Errorf("Count() = %d, want 1", g.Count())
+	}
+
+	g.Update(func(grid *HexGrid[int]) {
+		grid.Set(HexCoord{Q: 0, R: 0}, 1)
+		grid.Delete(HexCoord{Q: 1, R: 0})
+	})
+	if g.Get(HexCoord{Q: 0, R: 0}) != 1 || g.Count() != 1 {
+		t.Errorf("Update not applied: center = %d, count = %d", g.Get(HexCoord{}), g.Count())
+	}
+
+	snap := g.Snapshot()
+	g.Set(HexCoord{Q: 0, R: 0}, 9)
+	if snap.Get(HexCoord{Q: 0, R: 0}) != 1 {
+		t.Error("Snapshot changed after a later Set")
+	}
+}
+
+func TestSyncHexGrid_CallbackMayWrite(t *testing.T) {
+	g := NewSyncHexGrid[int](1)
+	g.Fill(1)
+
+	// Callbacks run outside the lock, so writing back must not deadlock
+	g.ForEachSet(func(coord HexCoord, value int) {
+		g.Set(coord, value+1)
+	})
+	g.ForEach(func(coord HexCoord, value int) {
+		if value != 2 {
+			t.Errorf("value at %v = %d, want 2", coord, value)
+		}
+	})
+}
+
+// Run with -race to check the wrapper guards the underlying map.
+func TestSyncHexGrid_ConcurrentSetAndIterate(t *testing.T) {
+	g := NewSyncHexGrid[int](5)
+	coords := g.Snapshot().All()
+
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				g.Set(coords[(i*7+w)%len(coords)], i)
+				if i%10 == 0 {
+					g.Delete(coords[(i+w)%len(coords)])
+				}
+			}
+		}(w)
+	}
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				count := 0
+				g.ForEachSet(func(HexCoord, int) { count++ })
+				if count > len(coords) {
+					t.Errorf("ForEachSet visited %d cells, grid has %d", count, len(coords))
+				}
+				g.Get(coords[i%len(coords)])
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -1,6 +1,9 @@
 package core
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestNewHexGrid(t *testing.T) {
 	tests := []struct {
@@ -308,6 +311,50 @@ func TestHexGridNeighbors(t *testing.T) {
 	}
 }
 
+func TestHexGridNeighborsWithDir(t *testing.T) {
+	grid := NewHexGrid[int](1)
+
+	center := grid.NeighborsWithDir(HexCoord{0, 0})
+	if len(center) != 6 {
+		t.Fatalf("Center neighbors = %d, want 6", len(center))
+	}
+	for i, n := range center {
+		if n.Dir != HexDirection(i) || n.Coord != (HexCoord{0, 0}).Neighbor(n.Dir) {
+			t.Errorf("Center neighbor %d = %+v, want direction %d", i, n, i)
+		}
+	}
+
+	want := []HexNeighbor{
+		{Dir: HexDirNW, Coord: HexCoord{1, -1}},
+		{Dir: HexDirW, Coord: HexCoord{0, 0}},
+		{Dir: HexDirSW, Coord: HexCoord{0, 1}},
+	}
+	if got := grid.NeighborsWithDir(HexCoord{1, 0}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Edge neighbors = %+v, want %+v", got, want)
+	}
+}
+
+func TestHexGridLineTo(t *testing.T) {
+	grid := NewHexGrid[int](2)
+
+	// From the center east past the edge at (2,0)
+	got := grid.LineTo(HexCoord{0, 0}, HexCoord{5, 0})
+	want := []HexCoord{{0, 0}, {1, 0}, {2, 0}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LineTo leaving the grid = %v, want %v", got, want)
+	}
+
+	// Lines inside the grid are unchanged
+	if got, want := grid.LineTo(HexCoord{-2, 0}, HexCoord{2, -2}), HexLine(HexCoord{-2, 0}, HexCoord{2, -2}); !reflect.DeepEqual(got, want) {
+		t.Errorf("LineTo inside the grid = %v, want %v", got, want)
+	}
+
+	// Starting outside gives nothing
+	if got := grid.LineTo(HexCoord{3, 0}, HexCoord{0, 0}); len(got) != 0 {
+		t.Errorf("LineTo from outside = %v, want empty", got)
+	}
+}
+
 func TestHexGridFill(t *testing.T) {
 	grid := NewHexGrid[string](1)
 	grid.Fill("x")
@@ -347,6 +394,77 @@ func TestHexGridClone(t *testing.T) {
 	}
 }
 
+func TestHexGridBlit(t *testing.T) {
+	chunk := NewHexGrid[string](1)
+	chunk.Set(HexCoord{0, 0}, "center")
+	chunk.Set(HexCoord{1, 0}, "east")
+	chunk.Set(HexCoord{0, -1}, "north")
+
+	// Placed at {2, 0}, the chunk's east cell lands on {3, 0}, outside a
+	// radius 2 map, and is skipped
+	world := NewHexGrid[string](2)
+	world.Set(HexCoord{2, 0}, "old")
+	world.Set(HexCoord{-2, 0}, "kept")
+	offset := HexCoord{2, 0}
+	if copied := world.Blit(chunk, offset); copied != 2 {
+		t.Errorf("Blit copied %d cells, want 2", copied)
+	}
+	want := map[HexCoord]string{
+		{2, 0}:  "center",
+		{2, -1}: "north",
+		{-2, 0}: "kept",
+	}
+	if world.Count() != len(want) {
+		t.Errorf("world has %d cells set, want %d", world.Count(), len(want))
+	}
+	for coord, value := range want {
+		if got := world.Get(coord); got != value {
+			t.Errorf("world %v = %q, want %q", coord, got, value)
+		}
+	}
+
+	// Translated grows to fit everything and leaves the source alone
+	moved := chunk.Translated(offset)
+	if moved.Radius() != 3 || moved.Count() != 3 {
+		t.Errorf("Translated has radius %d and %d cells, want 3 and 3", moved.Radius(), moved.Count())
+	}
+	chunk.ForEachSet(func(coord HexCoord, value string) {
+		if got := moved.Get(coord.Add(offset)); got != value {
+			t.Errorf("Translated %v = %q, want %q", coord.Add(offset), got, value)
+		}
+	})
+	if chunk.Get(HexCoord{0, 0}) != "center" || chunk.Count() != 3 {
+		t.Error("Translated changed the source grid")
+	}
+}
+
+func TestHexGridBlit_Self(t *testing.T) {
+	grid := NewHexGrid[string](3)
+	grid.Set(HexCoord{0, 0}, "a")
+	grid.Set(HexCoord{1, 0}, "b")
+	grid.Set(HexCoord{2, 0}, "c")
+
+	// Shifting a row east by one overlaps itself; every value must move
+	// exactly one cell rather than being carried along
+	if copied := grid.Blit(grid, HexCoord{1, 0}); copied != 3 {
+		t.Errorf("Blit copied %d cells, want 3", copied)
+	}
+	want := map[HexCoord]string{
+		{0, 0}: "a",
+		{1, 0}: "a",
+		{2, 0}: "b",
+		{3, 0}: "c",
+	}
+	if grid.Count() != len(want) {
+		t.Errorf("grid has %d cells set, want %d", grid.Count(), len(want))
+	}
+	for coord, value := range want {
+		if got := grid.Get(coord); got != value {
+			t.Errorf("grid %v = %q, want %q", coord, got, value)
+		}
+	}
+}
+
 func TestHexGridRadius4Size(t *testing.T) {
 	// Verify the specific radius 4 = 61 hexes requirement
 	grid := NewHexGrid[int](4)
@@ -395,3 +513,163 @@ func TestHexGridWithPointerType(t *testing.T) {
 		t.Error("Unset pointer should be nil")
 	}
 }
+
+func TestEqualHexGrid(t *testing.T) {
+	build := func(radius int, cells map[HexCoord]int) *HexGrid[int] {
+		g := NewHexGrid[int](radius)
+		for c, v := range cells {
+			g.Set(c, v)
+		}
+		return g
+	}
+	cells := map[HexCoord]int{{0, 0}: 1, {1, -1}: 2}
+
+	tests := []struct {
+		name string
+		a, b *HexGrid[int]
+		want bool
+	}{
+		{"identical", build(2, cells), build(2, cells), true},
+		{"both empty", build(1, nil), build(1, nil), true},
+		{"differing radius", build(2, cells), build(3, cells), false},
+		{"extra set cell", build(2, cells), build(2, map[HexCoord]int{{0, 0}: 1, {1, -1}: 2, {0, 1}: 0}), false},
+		{"differing value", build(2, cells), build(2, map[HexCoord]int{{0, 0}: 1, {1, -1}: 3}), false},
+		{"differing cell", build(2, cells), build(2, map[HexCoord]int{{0, 0}: 1, {-1, 1}: 2}), false},
+		{"nil and grid", nil, build(1, nil), false},
+		{"both nil", nil, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EqualHexGrid(tt.a, tt.b); got != tt.want {
+				t.Errorf("EqualHexGrid = %v, want %v", got, tt.want)
+			}
+			if got := EqualHexGrid(tt.b, tt.a); got != tt.want {
+				t.Errorf("EqualHexGrid (swapped) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEqualHexGridFunc(t *testing.T) {
+	a := NewHexGrid[[]int](1)
+	b := NewHexGrid[[]int](1)
+	a.Set(HexCoord{0, 0}, []int{1, 2})
+	b.Set(HexCoord{0, 0}, []int{1, 2})
+
+	eq := func(x, y []int) bool {
+		if len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if x[i] != y[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	if !EqualHexGridFunc(a, b, eq) {
+		t.Error("grids with equal slices should be equal")
+	}
+
+	b.Set(HexCoord{0, 0}, []int{1})
+	if EqualHexGridFunc(a, b, eq) {
+		t.Error("grids with differing slices should not be equal")
+	}
+}
+
+func TestDiffHexGrid(t *testing.T) {
+	from := NewHexGrid[int](2)
+	from.Set(HexCoord{0, 0}, 1)
+	from.Set(HexCoord{1, 0}, 2)
+	from.Set(HexCoord{0, 1}, 3)
+
+	to := from.Clone()
+	if got := DiffHexGrid(from, to); got != nil {
+		t.Errorf("DiffHexGrid of a clone = %v, want none", got)
+	}
+
+	to.Set(HexCoord{1, 0}, 5)  // Changed
+	to.Delete(HexCoord{0, 1})  // Removed
+	to.Set(HexCoord{2, -1}, 0) // Added, even as the zero value
+	to.Set(HexCoord{0, 0}, 1)  // Rewritten with the same value
+
+	want := []HexCellChange[int]{
+		{Coord: HexCoord{0, 1}, Removed: true},
+		{Coord: HexCoord{1, 0}, Value: 5},
+		{Coord: HexCoord{2, -1}, Value: 0},
+	}
+	got := DiffHexGridValues(from, to)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffHexGridValues = %v, want %v", got, want)
+	}
+	coords := DiffHexGrid(from, to)
+	if wantCoords := []HexCoord{{0, 1}, {1, 0}, {2, -1}}; !reflect.DeepEqual(coords, wantCoords) {
+		t.Errorf("DiffHexGrid = %v, want %v", coords, wantCoords)
+	}
+
+	// Applying the changes to the old grid reproduces the new one
+	patched := from.Clone()
+	for _, change := range got {
+		if change.Removed {
+			patched.Delete(change.Coord)
+		} else {
+			patched.Set(change.Coord, change.Value)
+		}
+	}
+	if !EqualHexGrid(patched, to) {
+		t.Error("applying the diff did not reproduce the new grid")
+	}
+
+	// A nil grid counts as empty
+	if got := DiffHexGrid(nil, from); len(got) != from.Count() {
+		t.Errorf("DiffHexGrid(nil, grid) = %v, want all %d set cells", got, from.Count())
+	}
+	for _, change := range DiffHexGridValues(from, nil) {
+		if !change.Removed {
+			t.Errorf("DiffHexGridValues(grid, nil) has %v, want only removals", change)
+		}
+	}
+}
+
+func TestHexGridFloodFill(t *testing.T) {
+	// A wall of 1s along the r = 0 row splits the 0s into two regions
+	grid := NewHexGrid[int](2)
+	for _, coord := range grid.All() {
+		if coord.R == 0 {
+			grid.Set(coord, 1)
+		}
+	}
+
+	sameValue := func(from, to HexCoord) bool {
+		return grid.Get(from) == grid.Get(to)
+	}
+
+	north := grid.FloodFill(HexCoord{Q: 0, R: -1}, sameValue)
+	if len(north) != 7 {
+		t.Errorf("north region has %d cells, want 7", len(north))
+	}
+	if north[0] != (HexCoord{Q: 0, R: -1}) {
+		t.Errorf("first cell = %v, want the start", north[0])
+	}
+	for _, coord := range north {
+		if coord.R >= 0 {
+			t.Errorf("fill leaked across the wall to %v", coord)
+		}
+	}
+
+	wall := grid.FloodFill(HexCoord{Q: 0, R: 0}, sameValue)
+	if len(wall) != 5 {
+		t.Errorf("wall region has %d cells, want 5", len(wall))
+	}
+
+	if got := grid.FloodFill(HexCoord{Q: 5, R: 0}, sameValue); got != nil {
+		t.Errorf("fill from outside the grid = %v, want nil", got)
+	}
+
+	none := grid.FloodFill(HexCoord{Q: 0, R: 1}, func(from, to HexCoord) bool { return false })
+	if len(none) != 1 {
+		t.Errorf("fill with no matches has %d cells, want only the start", len(none))
+	}
+}
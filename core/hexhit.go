@@ -18,6 +18,8 @@ type HexHitResult struct {
 	Cell     HexCoord   // The cell coordinate (valid for both Cell and Edge hits)
 	Edge     HexEdge    // The edge (only valid when Type == HexHitEdge)
 	Distance float32    // Distance from hit point to the element
+	Offset   Vec2       // Hit point relative to the cell center
+	Sextant  int        // Fill triangle (center, vertex i, vertex i+1) containing the point, 0-5
 }
 
 // PointToSegmentDistance calculates the minimum distance from a point to a line segment.
@@ -86,7 +88,7 @@ func (h *HexHitTester) HitTest(px, py float32) HexHitResult {
 	var nearestDir HexDirection
 
 	for dir := HexDirE; dir <= HexDirSE; dir++ {
-		v1, v2 := HexEdgeVertices(vertices, dir)
+		v1, v2 := HexEdgeVertices(vertices, dir, h.Layout.Orientation)
 		dist, _ := PointToSegmentDistance(px, py, v1.X, v1.Y, v2.X, v2.Y)
 		if dist < minDist {
 			minDist = dist
@@ -98,6 +100,9 @@ func (h *HexHitTester) HitTest(px, py float32) HexHitResult {
 	// to match the edge representation used elsewhere
 	nearestEdge = normalizeEdge(cell, nearestDir)
 
+	center := h.Layout.ToPixel(cell)
+	offset := Vec2{X: px - center.X, Y: py - center.Y}
+
 	// Determine hit type based on distance
 	if minDist <= h.EdgeThreshold {
 		return HexHitResult{
@@ -105,6 +110,8 @@ func (h *HexHitTester) HitTest(px, py float32) HexHitResult {
 			Cell:     cell,
 			Edge:     nearestEdge,
 			Distance: minDist,
+			Offset:   offset,
+			Sextant:  h.Layout.Sextant(offset),
 		}
 	}
 
@@ -113,9 +120,30 @@ func (h *HexHitTester) HitTest(px, py float32) HexHitResult {
 		Cell:     cell,
 		Edge:     nearestEdge, // Still provide nearest edge info
 		Distance: minDist,
+		Offset:   offset,
+		Sextant:  h.Layout.Sextant(offset),
 	}
 }
 
+// HexSextant returns which of a hex's six fill triangles contains the point
+// at offset from the cell center, in pixel coordinates (Y down).
+// Sextant i is the triangle between vertices i and i+1 as returned by
+// HexVertices for the given orientation, so sextant 0 runs clockwise from
+// vertex 0.
+func HexSextant(offset Vec2, orientation HexOrientation) int {
+	return HexLayout{Orientation: orientation}.Sextant(offset)
+}
+
+// Sextant is HexSextant for the layout's hexes, turned by its
+// VertexAngleOffset.
+func (l HexLayout) Sextant(offset Vec2) int {
+	// Vertex i sits at start - i*60°, measured with Y up
+	start := hexVertexStartAngle(l) * 180 / math.Pi
+	angle := math.Atan2(float64(-offset.Y), float64(offset.X)) * 180 / math.Pi
+	sextant := int(math.Floor((start - angle) / 60))
+	return ((sextant % 6) + 6) % 6
+}
+
 // HitTestCell returns only the cell at the given pixel coordinates.
 // This is a lightweight version of HitTest when you only need the cell.
 func (h *HexHitTester) HitTestCell(px, py float32) HexCoord {
@@ -132,7 +160,7 @@ func (h *HexHitTester) HitTestEdge(px, py float32) (HexEdge, float32) {
 	var nearestDir HexDirection
 
 	for dir := HexDirE; dir <= HexDirSE; dir++ {
-		v1, v2 := HexEdgeVertices(vertices, dir)
+		v1, v2 := HexEdgeVertices(vertices, dir, h.Layout.Orientation)
 		dist, _ := PointToSegmentDistance(px, py, v1.X, v1.Y, v2.X, v2.Y)
 		if dist < minDist {
 			minDist = dist
@@ -174,7 +202,7 @@ func (h *HexHitTester) HitTestInGrid(px, py float32, gridRadius int) HexHitResul
 // EdgeVertices returns the pixel coordinates of an edge's endpoints.
 func (h *HexHitTester) EdgeVertices(edge HexEdge) (Vec2, Vec2) {
 	vertices := HexVertices(h.Layout, edge.Coord, h.HexRadius)
-	return HexEdgeVertices(vertices, edge.Dir)
+	return HexEdgeVertices(vertices, edge.Dir, h.Layout.Orientation)
 }
 
 // EdgeMidpoint returns the pixel coordinates of an edge's midpoint.
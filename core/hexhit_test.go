@@ -308,3 +308,113 @@ func TestHexHitType_Coverage(t *testing.T) {
 		seen[typ] = true
 	}
 }
+
+func TestHexSextant_NearVertices(t *testing.T) {
+	layout := NewHexLayout(Vec2{X: 20, Y: 20}, Vec2{X: 100, Y: 100})
+	tester := NewHexHitTester(layout, 20, 2)
+	cell := HexCoord{Q: 1, R: -1}
+	center := tester.CellCenter(cell)
+	vertices := HexVertices(layout, cell, 20)
+
+	for i := 0; i < 6; i++ {
+		// Step from vertex i a little toward vertex i+1, then pull in
+		// toward the center so the point is well inside the cell
+		next := vertices[(i+1)%6]
+		px := vertices[i].X + (next.X-vertices[i].X)*0.2
+		py := vertices[i].Y + (next.Y-vertices[i].Y)*0.2
+		px = center.X + (px-center.X)*0.7
+		py = center.Y + (py-center.Y)*0.7
+
+		result := tester.HitTest(px, py)
+		if result.Cell != cell {
+			t.Fatalf("vertex %d: hit cell %v, want %v", i, result.Cell, cell)
+		}
+		if result.Sextant != i {
+			t.Errorf("vertex %d: Sextant = %d, want %d", i, result.Sextant, i)
+		}
+		want := Vec2{X: px - center.X, Y: py - center.Y}
+		if result.Offset != want {
+			t.Errorf("vertex %d: Offset = %v, want %v", i, result.Offset, want)
+		}
+	}
+}
+
+func TestHexSextant(t *testing.T) {
+	tests := []struct {
+		offset Vec2
+		want   int
+	}{
+		{Vec2{X: 1, Y: -10}, 0},  // Just right of the top vertex
+		{Vec2{X: 10, Y: -1}, 1},  // East flat, above center
+		{Vec2{X: 10, Y: 1}, 1},   // East flat, below center
+		{Vec2{X: 1, Y: 10}, 2},   // Just right of the bottom vertex
+		{Vec2{X: -1, Y: 10}, 3},  // Just left of the bottom vertex
+		{Vec2{X: -10, Y: 1}, 4},  // West flat, below center
+		{Vec2{X: -10, Y: -1}, 4}, // West flat, above center
+		{Vec2{X: -1, Y: -10}, 5}, // Just left of the top vertex
+	}
+
+	for _, tt := range tests {
+		if got := HexSextant(tt.offset, HexPointyTop); got != tt.want {
+			t.Errorf("HexSextant(%v) = %d, want %d", tt.offset, got, tt.want)
+		}
+	}
+}
+
+func TestHexHitTester_FlatTopEdges(t *testing.T) {
+	layout := NewFlatHexLayout(Vec2{X: 20, Y: 20}, Vec2{X: 100, Y: 100})
+	tester := NewHexHitTester(layout, 20, 3)
+	cell := HexCoord{Q: 1, R: -1}
+	center := tester.CellCenter(cell)
+
+	for dir := HexDirE; dir <= HexDirSE; dir++ {
+		// Step from the center toward the neighbor, stopping just short
+		// of the shared edge
+		neighbor := tester.CellCenter(cell.Neighbor(dir))
+		px := center.X + (neighbor.X-center.X)*0.45
+		py := center.Y + (neighbor.Y-center.Y)*0.45
+
+		result := tester.HitTest(px, py)
+		if result.Cell != cell {
+			t.Fatalf("dir %d: hit cell %v, want %v", dir, result.Cell, cell)
+		}
+		if result.Type != HexHitEdge {
+			t.Errorf("dir %d: Type = %v, want HexHitEdge", dir, result.Type)
+		}
+		want := normalizeEdge(cell, dir)
+		if result.Edge != want {
+			t.Errorf("dir %d: Edge = %v, want %v", dir, result.Edge, want)
+		}
+		if edge, _ := tester.HitTestEdge(px, py); edge != want {
+			t.Errorf("dir %d: HitTestEdge = %v, want %v", dir, edge, want)
+		}
+
+		mid := tester.EdgeMidpoint(want)
+		wantMid := Vec2{X: (center.X + neighbor.X) / 2, Y: (center.Y + neighbor.Y) / 2}
+		if math.Abs(float64(mid.X-wantMid.X)) > 0.01 || math.Abs(float64(mid.Y-wantMid.Y)) > 0.01 {
+			t.Errorf("dir %d: EdgeMidpoint = %v, want %v", dir, mid, wantMid)
+		}
+	}
+}
+
+func TestHexSextant_FlatTop(t *testing.T) {
+	tests := []struct {
+		offset Vec2
+		want   int
+	}{
+		{Vec2{X: 10, Y: 1}, 0},   // Just below the right vertex
+		{Vec2{X: 1, Y: 10}, 1},   // Bottom flat, right of center
+		{Vec2{X: -1, Y: 10}, 1},  // Bottom flat, left of center
+		{Vec2{X: -10, Y: 1}, 2},  // Just below the left vertex
+		{Vec2{X: -10, Y: -1}, 3}, // Just above the left vertex
+		{Vec2{X: -1, Y: -10}, 4}, // Top flat, left of center
+		{Vec2{X: 1, Y: -10}, 4},  // Top flat, right of center
+		{Vec2{X: 10, Y: -1}, 5},  // Just above the right vertex
+	}
+
+	for _, tt := range tests {
+		if got := HexSextant(tt.offset, HexFlatTop); got != tt.want {
+			t.Errorf("HexSextant(%v, HexFlatTop) = %d, want %d", tt.offset, got, tt.want)
+		}
+	}
+}
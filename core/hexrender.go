@@ -10,8 +10,17 @@ type HexCellStyle struct {
 
 // HexEdgeStyle defines the visual style for hex edges.
 type HexEdgeStyle struct {
-	Color  Color // Edge color
-	Dashed bool  // If true, render as dashed line
+	Color     Color   // Edge color
+	Dashed    bool    // If true, render as dashed line
+	Thickness float32 // World-space line width; 0 draws a 1px line
+
+	// RoundJoints caps thick 2D edges with a dot at each end, so edges
+	// meeting at a corner join smoothly instead of leaving notches.
+	RoundJoints bool
+
+	// DashOffset shifts the dash pattern of a dashed edge along it;
+	// animate it for a marching-ants outline. See DashIntervals.
+	DashOffset float32
 }
 
 // HexEdge represents an edge between two hex cells.
@@ -22,6 +31,17 @@ type HexEdge struct {
 	Dir   HexDirection // Direction of the edge (E=0, NE=1, NW=2 only)
 }
 
+// NewHexEdge returns the canonical edge shared by two adjacent cells, in
+// either order. Returns false if the cells are not neighbors.
+func NewHexEdge(a, b HexCoord) (HexEdge, bool) {
+	for dir := HexDirection(0); dir < 6; dir++ {
+		if a.Neighbor(dir) == b {
+			return normalizeEdge(a, dir), true
+		}
+	}
+	return HexEdge{}, false
+}
+
 // HexRenderConfig configures how a hex grid is rendered.
 type HexRenderConfig struct {
 	Layout       HexLayout    // Layout for hex-to-pixel conversion
@@ -32,6 +52,100 @@ type HexRenderConfig struct {
 	DrawEdges    bool         // Whether to draw edges
 	DashLength   float32      // Length of dash segments for dashed edges
 	DashGap      float32      // Gap between dashes
+
+	// GapFactor shrinks each cell's drawn radius to this fraction of
+	// HexRadius, leaving a gutter between cells while their centers stay
+	// put. 0 draws cells edge to edge, as does 1.
+	GapFactor float32
+
+	// ElevationFunc, if set, raises each cell's top face to the height it
+	// returns, turning the grid into terrain. With DrawWalls, raised cells
+	// also get side walls down to each lower neighbor, or to Y=0 at the
+	// edge of the grid.
+	ElevationFunc func(coord HexCoord) float32
+	DrawWalls     bool
+}
+
+// HexWall is a side wall of a raised cell: a vertical quad below the edge
+// in direction Dir, running down to the height of the neighbor beyond it.
+// Vertices run along the top edge, then back along the bottom.
+type HexWall struct {
+	Coord    HexCoord
+	Dir      HexDirection
+	Vertices [4]Vec3
+}
+
+// HexWallShade is how much wall colors are darkened from their cell's fill,
+// so walls read as the sides of the raised cells.
+const HexWallShade = 0.7
+
+// CellRadius returns the radius cells are drawn at: HexRadius scaled by
+// GapFactor. Hit testing and vertex positions keep using HexRadius.
+func (c HexRenderConfig) CellRadius() float32 {
+	if c.GapFactor <= 0 {
+		return c.HexRadius
+	}
+	return c.HexRadius * c.GapFactor
+}
+
+// Elevation returns the height of a cell's top face: 0 without an
+// ElevationFunc.
+func (c HexRenderConfig) Elevation(coord HexCoord) float32 {
+	if c.ElevationFunc == nil {
+		return 0
+	}
+	return c.ElevationFunc(coord)
+}
+
+// CellVertices3D returns the vertices a cell is drawn with: HexVertices3D
+// at CellRadius, raised to the cell's Elevation.
+func (c HexRenderConfig) CellVertices3D(coord HexCoord) [6]Vec3 {
+	vertices := HexVertices3D(c.Layout, coord, c.CellRadius())
+	y := c.Elevation(coord)
+	for i := range vertices {
+		vertices[i].Y = y
+	}
+	return vertices
+}
+
+// HexCellWalls returns the side walls of a cell: one below each edge whose
+// neighbor is lower, running down to the neighbor's Elevation, or to Y=0
+// for neighbors outside the grid as reported by inGrid. Returns nil without
+// an ElevationFunc.
+func HexCellWalls(config HexRenderConfig, coord HexCoord, inGrid func(HexCoord) bool) []HexWall {
+	if config.ElevationFunc == nil {
+		return nil
+	}
+
+	top := config.CellVertices3D(coord)
+	height := top[0].Y
+	var walls []HexWall
+	for dir := HexDirE; dir <= HexDirSE; dir++ {
+		var floor float32
+		if neighbor := coord.Neighbor(dir); inGrid(neighbor) {
+			floor = config.Elevation(neighbor)
+		}
+		if floor >= height {
+			continue
+		}
+
+		v1, v2 := HexEdgeVertices3D(top, dir, config.Layout.Orientation)
+		b1, b2 := v1, v2
+		b1.Y, b2.Y = floor, floor
+		walls = append(walls, HexWall{Coord: coord, Dir: dir, Vertices: [4]Vec3{v1, v2, b2, b1}})
+	}
+	return walls
+}
+
+// HexWallColor returns the color a wall of a cell with the given fill is
+// drawn with, darkened by HexWallShade.
+func HexWallColor(fill Color) Color {
+	return Color{
+		R: uint8(float32(fill.R) * HexWallShade),
+		G: uint8(float32(fill.G) * HexWallShade),
+		B: uint8(float32(fill.B) * HexWallShade),
+		A: fill.A,
+	}
 }
 
 // DefaultHexRenderConfig returns a default hex render configuration.
@@ -54,16 +168,18 @@ func DefaultHexRenderConfig(hexRadius float32) HexRenderConfig {
 }
 
 // HexVertices returns the 6 vertices of a hex at the given coordinate.
-// Uses pointy-top orientation (first vertex at top).
-// Returns vertices in counter-clockwise order starting from top.
+// Vertices run clockwise on screen, starting from the top vertex for a
+// pointy-top layout or the right vertex for a flat-top layout, turned by
+// the layout's VertexAngleOffset.
 func HexVertices(layout HexLayout, coord HexCoord, radius float32) [6]Vec2 {
 	center := layout.ToPixel(coord)
 	var vertices [6]Vec2
 
-	// Pointy-top: vertices at angles 90°, 30°, -30°, -90°, -150°, 150° (or 90, 30, 330, 270, 210, 150)
-	// Start at top (90°) and go clockwise
+	// Pointy-top: vertices at angles 90°, 30°, -30°, -90°, -150°, 150°
+	// Flat-top: vertices at angles 0°, -60°, -120°, 180°, 120°, 60°
+	start := hexVertexStartAngle(layout)
 	for i := 0; i < 6; i++ {
-		angle := math.Pi/2 - float64(i)*math.Pi/3 // 90° - i*60°
+		angle := start - float64(i)*math.Pi/3 // start - i*60°
 		vertices[i] = Vec2{
 			X: center.X + radius*float32(math.Cos(angle)),
 			Y: center.Y - radius*float32(math.Sin(angle)), // Negate Y for screen coords
@@ -73,6 +189,16 @@ func HexVertices(layout HexLayout, coord HexCoord, radius float32) [6]Vec2 {
 	return vertices
 }
 
+// hexVertexStartAngle returns the angle of vertex 0 in radians, measured
+// counter-clockwise from +X with Y up.
+func hexVertexStartAngle(layout HexLayout) float64 {
+	offset := float64(DegToRad(layout.VertexAngleOffset))
+	if layout.Orientation == HexFlatTop {
+		return offset
+	}
+	return math.Pi/2 + offset
+}
+
 // HexVertices3D returns the 6 vertices of a hex in 3D space (on the XZ plane at Y=0).
 func HexVertices3D(layout HexLayout, coord HexCoord, radius float32) [6]Vec3 {
 	v2 := HexVertices(layout, coord, radius)
@@ -83,39 +209,89 @@ func HexVertices3D(layout HexLayout, coord HexCoord, radius float32) [6]Vec3 {
 	return vertices
 }
 
-// HexEdgeVertices returns the two vertices that form the edge in the given direction.
-// For pointy-top hexes:
+// HexCenter3D returns the center of a hex in 3D space (on the XZ plane at Y=0).
+func HexCenter3D(layout HexLayout, coord HexCoord) Vec3 {
+	center := layout.ToPixel(coord)
+	return Vec3{X: center.X, Y: 0, Z: center.Y}
+}
+
+// HexLabelScale returns the glyph scale at which text fits inside a hex of
+// the given radius: at most 80% of the hex's flat-to-flat width and half its
+// radius tall. Returns 0 for empty text or a nil font.
+func HexLabelScale(font *HersheyFont, text string, radius float32) float32 {
+	if font == nil || font.Height <= 0 {
+		return 0
+	}
+	width := font.MeasureText(text, 1)
+	if width <= 0 {
+		return 0
+	}
+
+	widthScale := 0.8 * sqrt3 * radius / width
+	heightScale := 0.5 * radius / float32(font.Height)
+	return min(widthScale, heightScale)
+}
+
+// GridPixelBounds returns the axis-aligned bounding box, in layout pixel
+// coordinates, of every vertex of every valid cell in the grid, so
+// vertices on the outer edge are included.
+func GridPixelBounds[T any](grid *HexGrid[T], layout HexLayout, radius float32) (min, max Vec2) {
+	min = Vec2{X: math.MaxFloat32, Y: math.MaxFloat32}
+	max = Vec2{X: -math.MaxFloat32, Y: -math.MaxFloat32}
+
+	// Inner cells lie inside the hull of the outer ring, so only the
+	// outer ring can reach the edge of the box
+	for _, coord := range grid.Ring(grid.Radius()) {
+		for _, v := range HexVertices(layout, coord, radius) {
+			min.X = float32(math.Min(float64(min.X), float64(v.X)))
+			min.Y = float32(math.Min(float64(min.Y), float64(v.Y)))
+			max.X = float32(math.Max(float64(max.X), float64(v.X)))
+			max.Y = float32(math.Max(float64(max.Y), float64(v.Y)))
+		}
+	}
+	return min, max
+}
+
+// hexEdgeVertexMap holds the HexVertices indices of each edge direction's
+// endpoints, per orientation. For pointy-top hexes:
 // - E edge: vertices 1 and 2 (right side)
 // - NE edge: vertices 0 and 1 (upper right)
 // - NW edge: vertices 5 and 0 (upper left)
 // - W edge: vertices 4 and 5 (left side)
 // - SW edge: vertices 3 and 4 (lower left)
 // - SE edge: vertices 2 and 3 (lower right)
-func HexEdgeVertices(vertices [6]Vec2, dir HexDirection) (Vec2, Vec2) {
-	// Vertex indices for each edge direction
-	edgeVertexMap := [6][2]int{
+// Flat-top hexes number their vertices from the right instead of the top,
+// which shifts every pair down by one.
+var hexEdgeVertexMap = [2][6][2]int{
+	HexPointyTop: {
 		{1, 2}, // E
 		{0, 1}, // NE
 		{5, 0}, // NW
 		{4, 5}, // W
 		{3, 4}, // SW
 		{2, 3}, // SE
-	}
-	idx := edgeVertexMap[dir]
+	},
+	HexFlatTop: {
+		{0, 1}, // E (lower right)
+		{5, 0}, // NE (upper right)
+		{4, 5}, // NW (top)
+		{3, 4}, // W (upper left)
+		{2, 3}, // SW (lower left)
+		{1, 2}, // SE (bottom)
+	},
+}
+
+// HexEdgeVertices returns the two vertices that form the edge in the given
+// direction, for vertices returned by HexVertices with a layout of the given
+// orientation. The vertices are returned in clockwise order.
+func HexEdgeVertices(vertices [6]Vec2, dir HexDirection, orientation HexOrientation) (Vec2, Vec2) {
+	idx := hexEdgeVertexMap[orientation][dir]
 	return vertices[idx[0]], vertices[idx[1]]
 }
 
 // HexEdgeVertices3D returns the two vertices of an edge in 3D space.
-func HexEdgeVertices3D(vertices [6]Vec3, dir HexDirection) (Vec3, Vec3) {
-	edgeVertexMap := [6][2]int{
-		{1, 2}, // E
-		{0, 1}, // NE
-		{5, 0}, // NW
-		{4, 5}, // W
-		{3, 4}, // SW
-		{2, 3}, // SE
-	}
-	idx := edgeVertexMap[dir]
+func HexEdgeVertices3D(vertices [6]Vec3, dir HexDirection, orientation HexOrientation) (Vec3, Vec3) {
+	idx := hexEdgeVertexMap[orientation][dir]
 	return vertices[idx[0]], vertices[idx[1]]
 }
 
@@ -171,22 +347,168 @@ func BoundaryEdges[T any](grid *HexGrid[T]) []HexEdge {
 	return edges
 }
 
+// TraceBoundary returns the boundary of a region of the grid as ordered edge
+// loops, one per connected boundary (holes produce their own loops).
+// A cell belongs to the region if it is valid in the grid and region returns
+// true for it; a nil region selects every valid cell.
+//
+// Each edge is reported from the perspective of the region cell it belongs
+// to (any of the 6 directions, like BoundaryEdges). Consecutive edges in a
+// loop share a vertex: the second vertex returned by HexEdgeVertices for one
+// edge is the first vertex of the next, and the last edge connects back to
+// the first.
+func TraceBoundary[T any](g *HexGrid[T], region func(HexCoord) bool) [][]HexEdge {
+	inRegion := func(coord HexCoord) bool {
+		return g.IsValid(coord) && (region == nil || region(coord))
+	}
+
+	// Collect boundary edges in a deterministic order
+	var boundary []HexEdge
+	for _, coord := range g.All() {
+		if !inRegion(coord) {
+			continue
+		}
+		for dir := HexDirE; dir <= HexDirSE; dir++ {
+			if !inRegion(coord.Neighbor(dir)) {
+				boundary = append(boundary, HexEdge{Coord: coord, Dir: dir})
+			}
+		}
+	}
+
+	visited := make(map[HexEdge]bool, len(boundary))
+	var loops [][]HexEdge
+
+	for _, start := range boundary {
+		if visited[start] {
+			continue
+		}
+
+		var loop []HexEdge
+		edge := start
+		for !visited[edge] {
+			visited[edge] = true
+			loop = append(loop, edge)
+			edge = nextBoundaryEdge(edge, inRegion)
+		}
+		loops = append(loops, loop)
+	}
+
+	return loops
+}
+
+// ZoneBoundary returns the outer outline of a connected set of cells, such
+// as a territory, as one ordered loop of edges. Edges are reported from the
+// perspective of the zone cell they belong to and chained as in
+// TraceBoundary, so the loop can be drawn as a single closed outline; holes
+// inside the zone are not included. It returns nil for an empty set.
+func ZoneBoundary(cells []HexCoord) []HexEdge {
+	if len(cells) == 0 {
+		return nil
+	}
+
+	zone := make(map[HexCoord]bool, len(cells))
+	start := cells[0]
+	for _, coord := range cells {
+		zone[coord] = true
+		if coord.Q > start.Q {
+			start = coord
+		}
+	}
+	inZone := func(coord HexCoord) bool { return zone[coord] }
+
+	// Nothing lies east of the easternmost cell, so its east edge faces
+	// the open outside rather than a hole
+	var loop []HexEdge
+	first := HexEdge{Coord: start, Dir: HexDirE}
+	for edge := first; len(loop) == 0 || edge != first; edge = nextBoundaryEdge(edge, inZone) {
+		loop = append(loop, edge)
+	}
+	return loop
+}
+
+// nextBoundaryEdge returns the boundary edge that continues clockwise from
+// the end vertex of edge. The end vertex is shared by the edge's cell, the
+// outside cell across the edge, and the cell in the next clockwise direction.
+func nextBoundaryEdge(edge HexEdge, inRegion func(HexCoord) bool) HexEdge {
+	// Edges in clockwise order around a cell are NE, E, SE, SW, W, NW
+	nextDir := (edge.Dir + 5) % 6
+	pivot := edge.Coord.Neighbor(nextDir)
+
+	if !inRegion(pivot) {
+		// Convex corner: continue along the same cell
+		return HexEdge{Coord: edge.Coord, Dir: nextDir}
+	}
+
+	// Concave corner: continue along the pivot cell, facing the same
+	// outside cell
+	return HexEdge{Coord: pivot, Dir: (edge.Dir + 1) % 6}
+}
+
+// HexColorResolver returns the color associated with a cell.
+type HexColorResolver func(coord HexCoord) Color
+
+// BlendedEdgeColor returns the color for an edge as the average of the
+// colors of the two cells it separates. If only one of the cells is in the
+// grid (a boundary edge), that cell's color is used unblended.
+func BlendedEdgeColor(edge HexEdge, inGrid func(coord HexCoord) bool, resolve HexColorResolver) Color {
+	a := edge.Coord
+	b := edge.Coord.Neighbor(edge.Dir)
+
+	aValid, bValid := inGrid(a), inGrid(b)
+	switch {
+	case aValid && bValid:
+		return lerpColor(resolve(a), resolve(b), 0.5)
+	case bValid:
+		return resolve(b)
+	default:
+		return resolve(a)
+	}
+}
+
 // HexGridRenderData holds pre-computed rendering data for a hex grid.
 type HexGridRenderData struct {
-	Cells       []HexCoord  // All cell coordinates
-	Vertices    [][6]Vec3   // Vertices for each cell (same index as Cells)
-	AllEdges    []HexEdge   // All unique edges
-	BoundaryEdges []HexEdge // Edges on the grid boundary
-	InteriorEdges []HexEdge // Edges between cells
+	Cells         []HexCoord // All cell coordinates
+	Vertices      [][6]Vec3  // Vertices for each cell (same index as Cells)
+	AllEdges      []HexEdge  // All unique edges
+	BoundaryEdges []HexEdge  // Edges on the grid boundary
+	InteriorEdges []HexEdge  // Edges between cells
+
+	// RingIndex is each cell's distance from the center (same index as
+	// Cells), and CellsByRing lists the indices into Cells of each ring,
+	// for revealing or animating a grid ring by ring.
+	RingIndex   []int
+	CellsByRing [][]int
+
+	// Walls are the side walls of raised cells, when the config has an
+	// ElevationFunc. See HexCellWalls.
+	Walls []HexWall
+
+	cellIndex map[HexCoord]int // Index into Cells, built on demand
+	indexed   []HexCoord       // Cells as of the last cellIndex build
 }
 
 // PrepareGridRenderData computes all the rendering data for a hex grid.
 func PrepareGridRenderData[T any](grid *HexGrid[T], config HexRenderConfig) HexGridRenderData {
 	cells := grid.All()
 	vertices := make([][6]Vec3, len(cells))
+	cellIndex := make(map[HexCoord]int, len(cells))
+	ringIndex := make([]int, len(cells))
+	cellsByRing := make([][]int, grid.Radius()+1)
 
 	for i, coord := range cells {
-		vertices[i] = HexVertices3D(config.Layout, coord, config.HexRadius)
+		vertices[i] = config.CellVertices3D(coord)
+		cellIndex[coord] = i
+
+		ring := coord.Length()
+		ringIndex[i] = ring
+		cellsByRing[ring] = append(cellsByRing[ring], i)
+	}
+
+	var walls []HexWall
+	if config.ElevationFunc != nil {
+		for _, coord := range cells {
+			walls = append(walls, HexCellWalls(config, coord, grid.IsValid)...)
+		}
 	}
 
 	return HexGridRenderData{
@@ -195,5 +517,97 @@ func PrepareGridRenderData[T any](grid *HexGrid[T], config HexRenderConfig) HexG
 		AllEdges:      GridEdges(grid),
 		BoundaryEdges: BoundaryEdges(grid),
 		InteriorEdges: InteriorEdges(grid),
+		RingIndex:     ringIndex,
+		CellsByRing:   cellsByRing,
+		Walls:         walls,
+		cellIndex:     cellIndex,
+		indexed:       cells,
+	}
+}
+
+// IndexOf returns the index of a cell in Cells and Vertices.
+// Returns false if the cell is not part of the render data. The index is
+// rebuilt when Cells is assigned a different slice; after changing Cells'
+// elements in place, assign it to a fresh slice with slices.Clone.
+func (d *HexGridRenderData) IndexOf(coord HexCoord) (int, bool) {
+	if d.cellIndex == nil || !sameSlice(d.Cells, d.indexed) {
+		d.cellIndex = make(map[HexCoord]int, len(d.Cells))
+		for i, c := range d.Cells {
+			d.cellIndex[c] = i
+		}
+		d.indexed = d.Cells
+	}
+	idx, ok := d.cellIndex[coord]
+	return idx, ok
+}
+
+// sameSlice reports whether a and b share the same backing array and length.
+func sameSlice(a, b []HexCoord) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	return len(a) == 0 || &a[0] == &b[0]
+}
+
+// UpdateCell recomputes the vertices of a single cell in place, without
+// rebuilding the rest of the render data. Edges look up their endpoints from
+// the cell vertices, so the cell's incident edges pick up the change too.
+// The cell keeps its elevation. Cells that are not part of the render data
+// are ignored.
+func (d *HexGridRenderData) UpdateCell(layout HexLayout, radius float32, coord HexCoord) {
+	idx, ok := d.IndexOf(coord)
+	if !ok {
+		return
+	}
+	y := d.Vertices[idx][0].Y
+	d.Vertices[idx] = HexVertices3D(layout, coord, radius)
+	for i := range d.Vertices[idx] {
+		d.Vertices[idx][i].Y = y
 	}
 }
+
+// HexGridMesh is a flat triangle list covering the filled cells of a grid,
+// ready to upload to the GPU as a single mesh.
+type HexGridMesh struct {
+	Vertices []float32 // XYZ per vertex, three vertices per triangle
+	Colors   []uint8   // RGBA per vertex
+}
+
+// VertexCount returns the number of vertices in the mesh.
+func (m HexGridMesh) VertexCount() int {
+	return len(m.Vertices) / 3
+}
+
+// TriangleCount returns the number of triangles in the mesh.
+func (m HexGridMesh) TriangleCount() int {
+	return m.VertexCount() / 3
+}
+
+// BuildHexGridMesh triangulates every cell in data as six triangles fanned
+// from its center, colored by fill. Cells whose fill is fully transparent
+// are left out, matching what HexRenderer.DrawGrid draws.
+func BuildHexGridMesh(data HexGridRenderData, fill func(coord HexCoord) Color) HexGridMesh {
+	mesh := HexGridMesh{
+		Vertices: make([]float32, 0, len(data.Cells)*6*3*3),
+		Colors:   make([]uint8, 0, len(data.Cells)*6*3*4),
+	}
+
+	for i, coord := range data.Cells {
+		color := fill(coord)
+		if color.A == 0 {
+			continue
+		}
+
+		vertices := data.Vertices[i]
+		center := vertices[0].Add(vertices[3]).Scale(0.5)
+
+		for j := 0; j < 6; j++ {
+			for _, v := range [3]Vec3{center, vertices[j], vertices[(j+1)%6]} {
+				mesh.Vertices = append(mesh.Vertices, v.X, v.Y, v.Z)
+				mesh.Colors = append(mesh.Colors, color.R, color.G, color.B, color.A)
+			}
+		}
+	}
+
+	return mesh
+}
@@ -92,7 +92,7 @@ func TestHexEdgeVertices(t *testing.T) {
 
 	// Test each edge direction returns two distinct vertices
 	for dir := HexDirE; dir <= HexDirSE; dir++ {
-		v1, v2 := HexEdgeVertices(vertices, dir)
+		v1, v2 := HexEdgeVertices(vertices, dir, HexPointyTop)
 		if v1 == v2 {
 			t.Errorf("Edge direction %d has identical vertices", dir)
 		}
@@ -106,6 +106,104 @@ func TestHexEdgeVertices(t *testing.T) {
 	}
 }
 
+func TestHexVertices_FlatTop(t *testing.T) {
+	layout := NewFlatHexLayout(Vec2{X: 10, Y: 10}, Vec2{X: 0, Y: 0})
+	vertices := HexVertices(layout, HexCoord{Q: 0, R: 0}, 10)
+
+	// Vertex 0 is the right vertex; vertices 1 and 2 form the flat bottom
+	if math.Abs(float64(vertices[0].X-10)) > 0.001 || math.Abs(float64(vertices[0].Y)) > 0.001 {
+		t.Errorf("vertex 0 = %v, want {10, 0}", vertices[0])
+	}
+	if math.Abs(float64(vertices[1].Y-vertices[2].Y)) > 0.001 || vertices[1].Y <= 0 {
+		t.Errorf("vertices 1 and 2 = %v, %v, want a flat bottom edge", vertices[1], vertices[2])
+	}
+}
+
+func TestHexVertices_AngleOffset(t *testing.T) {
+	// angle returns the direction of p from c counter-clockwise on screen
+	angle := func(p, c Vec2) float64 {
+		return math.Atan2(float64(c.Y-p.Y), float64(p.X-c.X))
+	}
+
+	for _, base := range []HexLayout{
+		NewHexLayout(Vec2{X: 10, Y: 10}, Vec2{X: 30, Y: -20}),
+		NewFlatHexLayout(Vec2{X: 10, Y: 10}, Vec2{X: 30, Y: -20}),
+	} {
+		coord := HexCoord{Q: 2, R: -1}
+		rotated := base
+		rotated.VertexAngleOffset = 15
+
+		center := base.ToPixel(coord)
+		if got := rotated.ToPixel(coord); got != center {
+			t.Errorf("orientation %v: rotated center = %v, want %v", base.Orientation, got, center)
+		}
+
+		// Each vertex stays at the radius, turned 15° from where it was
+		plain := HexVertices(base, coord, 10)
+		turned := HexVertices(rotated, coord, 10)
+		for i, v := range turned {
+			dist := math.Hypot(float64(v.X-center.X), float64(v.Y-center.Y))
+			if math.Abs(dist-10) > 0.001 {
+				t.Errorf("orientation %v vertex %d: distance = %f, want 10", base.Orientation, i, dist)
+			}
+			turn := angle(v, center) - angle(plain[i], center)
+			if diff := math.Remainder(turn-float64(DegToRad(15)), 2*math.Pi); math.Abs(diff) > 1e-4 {
+				t.Errorf("orientation %v vertex %d: turned %f°, want 15°", base.Orientation, i, turn*180/math.Pi)
+			}
+		}
+
+		// Edge hits and sextants follow the turned vertices
+		tester := NewHexHitTester(rotated, 10, 1)
+		for dir := HexDirE; dir <= HexDirSE; dir++ {
+			v1, v2 := HexEdgeVertices(turned, dir, rotated.Orientation)
+			x := center.X + ((v1.X+v2.X)/2-center.X)*0.95
+			y := center.Y + ((v1.Y+v2.Y)/2-center.Y)*0.95
+			if edge, _ := tester.HitTestEdge(x, y); edge != normalizeEdge(coord, dir) {
+				t.Errorf("orientation %v: edge near %v = %v, want %v", base.Orientation, dir, edge, normalizeEdge(coord, dir))
+			}
+		}
+		for i, v := range turned {
+			next := turned[(i+1)%6]
+			offset := Vec2{X: (v.X+next.X)/2 - center.X, Y: (v.Y+next.Y)/2 - center.Y}
+			if got := rotated.Sextant(offset); got != i {
+				t.Errorf("orientation %v: Sextant between vertices %d and %d = %d", base.Orientation, i, i+1, got)
+			}
+		}
+	}
+}
+
+func TestHexEdgeVertices_FacesNeighbor(t *testing.T) {
+	// The midpoint of each edge must lie halfway between the cell and the
+	// neighbor in that direction, whatever the orientation.
+	layouts := []HexLayout{
+		NewHexLayout(Vec2{X: 10, Y: 10}, Vec2{X: 5, Y: 5}),
+		NewFlatHexLayout(Vec2{X: 10, Y: 10}, Vec2{X: 5, Y: 5}),
+	}
+	coord := HexCoord{Q: 1, R: -2}
+
+	for _, layout := range layouts {
+		vertices := HexVertices(layout, coord, 10)
+		vertices3D := HexVertices3D(layout, coord, 10)
+		center := layout.ToPixel(coord)
+
+		for dir := HexDirE; dir <= HexDirSE; dir++ {
+			v1, v2 := HexEdgeVertices(vertices, dir, layout.Orientation)
+			neighbor := layout.ToPixel(coord.Neighbor(dir))
+			mid := Vec2{X: (v1.X + v2.X) / 2, Y: (v1.Y + v2.Y) / 2}
+			want := Vec2{X: (center.X + neighbor.X) / 2, Y: (center.Y + neighbor.Y) / 2}
+			if math.Abs(float64(mid.X-want.X)) > 0.01 || math.Abs(float64(mid.Y-want.Y)) > 0.01 {
+				t.Errorf("orientation %d dir %d: edge midpoint = %v, want %v", layout.Orientation, dir, mid, want)
+			}
+
+			a, b := HexEdgeVertices3D(vertices3D, dir, layout.Orientation)
+			if a.X != v1.X || a.Z != v1.Y || b.X != v2.X || b.Z != v2.Y {
+				t.Errorf("orientation %d dir %d: 3D edge %v-%v does not match 2D edge %v-%v",
+					layout.Orientation, dir, a, b, v1, v2)
+			}
+		}
+	}
+}
+
 func TestGridEdges(t *testing.T) {
 	grid := NewHexGrid[int](1) // Radius 1 = 7 cells
 
@@ -237,6 +335,146 @@ func TestPrepareGridRenderData(t *testing.T) {
 	}
 }
 
+func TestHexGridRenderData_IndexOfAfterReassign(t *testing.T) {
+	data := PrepareGridRenderData(NewHexGrid[int](1), DefaultHexRenderConfig(10.0))
+	last := data.Cells[len(data.Cells)-1]
+	if idx, _ := data.IndexOf(last); idx != len(data.Cells)-1 {
+		t.Fatalf("IndexOf(%v) = %d, want %d", last, idx, len(data.Cells)-1)
+	}
+
+	// A same-length slice in a new order must not reuse the stale index
+	reversed := make([]HexCoord, len(data.Cells))
+	for i, c := range data.Cells {
+		reversed[len(reversed)-1-i] = c
+	}
+	data.Cells = reversed
+	if idx, ok := data.IndexOf(last); !ok || idx != 0 {
+		t.Errorf("IndexOf(%v) after reassigning Cells = %d, %v, want 0, true", last, idx, ok)
+	}
+}
+
+func TestPrepareGridRenderData_Rings(t *testing.T) {
+	grid := NewHexGrid[int](2)
+	data := PrepareGridRenderData(grid, DefaultHexRenderConfig(10.0))
+
+	if len(data.RingIndex) != len(data.Cells) {
+		t.Fatalf("RingIndex has %d entries, want %d", len(data.RingIndex), len(data.Cells))
+	}
+	for i, coord := range data.Cells {
+		if data.RingIndex[i] != coord.Length() {
+			t.Errorf("RingIndex[%d] = %d, want %d for %v", i, data.RingIndex[i], coord.Length(), coord)
+		}
+	}
+
+	// Rings 0, 1 and 2 hold 1, 6 and 12 cells
+	wantSizes := []int{1, 6, 12}
+	if len(data.CellsByRing) != len(wantSizes) {
+		t.Fatalf("CellsByRing has %d rings, want %d", len(data.CellsByRing), len(wantSizes))
+	}
+	for ring, indices := range data.CellsByRing {
+		if len(indices) != wantSizes[ring] {
+			t.Errorf("ring %d has %d cells, want %d", ring, len(indices), wantSizes[ring])
+		}
+		for _, i := range indices {
+			if data.RingIndex[i] != ring {
+				t.Errorf("ring %d lists cell %v at distance %d", ring, data.Cells[i], data.RingIndex[i])
+			}
+		}
+	}
+}
+
+func TestHexRenderConfig_GapFactor(t *testing.T) {
+	config := DefaultHexRenderConfig(10.0)
+	if got := config.CellRadius(); got != 10 {
+		t.Errorf("CellRadius with no gap = %g, want 10", got)
+	}
+
+	config.GapFactor = 0.8
+	if got := config.CellRadius(); math.Abs(float64(got-8)) > 1e-5 {
+		t.Errorf("CellRadius with GapFactor 0.8 = %g, want 8", got)
+	}
+
+	// Vertices pull in toward centers that do not move
+	data := PrepareGridRenderData(NewHexGrid[int](1), config)
+	for i, coord := range data.Cells {
+		center := HexCenter3D(config.Layout, coord)
+		for j, v := range data.Vertices[i] {
+			if d := v.Sub(center).Length(); math.Abs(float64(d-8)) > 1e-4 {
+				t.Errorf("cell %v vertex %d is %g from its center, want 8", coord, j, d)
+			}
+		}
+		full := HexVertices3D(config.Layout, coord, config.HexRadius)
+		for j := range full {
+			want := center.Add(full[j].Sub(center).Scale(0.8))
+			if !vec3Near(data.Vertices[i][j], want) {
+				t.Errorf("cell %v vertex %d = %v, want %v", coord, j, data.Vertices[i][j], want)
+			}
+		}
+	}
+}
+
+func TestPrepareGridRenderData_Elevation(t *testing.T) {
+	grid := NewHexGrid[int](1)
+	config := DefaultHexRenderConfig(10.0)
+	if data := PrepareGridRenderData(grid, config); data.Walls != nil {
+		t.Errorf("flat grid has %d walls, want none", len(data.Walls))
+	}
+
+	// The center is a plateau at 10, its east neighbor a peak at 12, and
+	// the rest of the ring lowland at 4
+	east := HexCoord{}.Neighbor(HexDirE)
+	config.ElevationFunc = func(coord HexCoord) float32 {
+		switch coord {
+		case HexCoord{}:
+			return 10
+		case east:
+			return 12
+		}
+		return 4
+	}
+	data := PrepareGridRenderData(grid, config)
+
+	for i, coord := range data.Cells {
+		for j, v := range data.Vertices[i] {
+			if want := config.Elevation(coord); v.Y != want {
+				t.Errorf("cell %v vertex %d at Y %g, want %g", coord, j, v.Y, want)
+			}
+		}
+	}
+
+	walls := make(map[HexCoord][]HexWall)
+	for _, wall := range data.Walls {
+		walls[wall.Coord] = append(walls[wall.Coord], wall)
+	}
+
+	// Five walls drop from the center to the lowland; none face the peak
+	if got := len(walls[HexCoord{}]); got != 5 {
+		t.Errorf("center has %d walls, want 5", got)
+	}
+	idx, _ := data.IndexOf(HexCoord{})
+	for _, wall := range walls[HexCoord{}] {
+		if wall.Dir == HexDirE {
+			t.Errorf("center has a wall facing the higher east cell")
+		}
+		v1, v2 := HexEdgeVertices3D(data.Vertices[idx], wall.Dir, config.Layout.Orientation)
+		b1, b2 := v1, v2
+		b1.Y, b2.Y = 4, 4
+		if want := [4]Vec3{v1, v2, b2, b1}; wall.Vertices != want {
+			t.Errorf("center wall %v = %v, want %v", wall.Dir, wall.Vertices, want)
+		}
+	}
+
+	// The peak drops to the center and lowland inside the grid, and to
+	// Y=0 outside it
+	floors := make(map[float32]int)
+	for _, wall := range walls[east] {
+		floors[wall.Vertices[2].Y]++
+	}
+	if floors[10] != 1 || floors[4] != 2 || floors[0] != 3 {
+		t.Errorf("peak wall floors = %v, want one at 10, two at 4, three at 0", floors)
+	}
+}
+
 func TestDefaultHexRenderConfig(t *testing.T) {
 	config := DefaultHexRenderConfig(20.0)
 
@@ -256,3 +494,354 @@ func TestDefaultHexRenderConfig(t *testing.T) {
 		t.Error("Config DefaultEdge.Dashed should be false by default")
 	}
 }
+
+func TestBlendedEdgeColor(t *testing.T) {
+	grid := NewHexGrid[Color](1)
+	grid.Set(HexCoord{Q: 0, R: 0}, Color{R: 200, G: 0, B: 100, A: 255})
+	grid.Set(HexCoord{Q: 1, R: 0}, Color{R: 0, G: 100, B: 50, A: 255})
+
+	resolve := func(coord HexCoord) Color { return grid.Get(coord) }
+
+	// Interior edge between center and its east neighbor
+	interior := HexEdge{Coord: HexCoord{Q: 0, R: 0}, Dir: HexDirE}
+	got := BlendedEdgeColor(interior, grid.IsValid, resolve)
+	want := Color{R: 100, G: 50, B: 75, A: 255}
+	if got != want {
+		t.Errorf("BlendedEdgeColor(interior) = %v, want %v", got, want)
+	}
+
+	// Boundary edge on the east cell's outer side uses the single cell color
+	boundary := HexEdge{Coord: HexCoord{Q: 1, R: 0}, Dir: HexDirE}
+	got = BlendedEdgeColor(boundary, grid.IsValid, resolve)
+	if got != grid.Get(HexCoord{Q: 1, R: 0}) {
+		t.Errorf("BlendedEdgeColor(boundary) = %v, want %v", got, grid.Get(HexCoord{Q: 1, R: 0}))
+	}
+
+	// Canonical edge owned by a coord outside the grid uses the inner cell
+	outer := HexEdge{Coord: HexCoord{Q: -2, R: 1}, Dir: HexDirE}
+	grid.Set(HexCoord{Q: -1, R: 1}, ColorSkyBlue)
+	got = BlendedEdgeColor(outer, grid.IsValid, resolve)
+	if got != ColorSkyBlue {
+		t.Errorf("BlendedEdgeColor(outer) = %v, want %v", got, ColorSkyBlue)
+	}
+}
+
+// checkLoopConnected verifies that consecutive edges in a loop share a
+// vertex, in both orientations.
+func checkLoopConnected(t *testing.T, loop []HexEdge) {
+	t.Helper()
+	layouts := []HexLayout{
+		NewHexLayout(Vec2{X: 10, Y: 10}, Vec2{X: 0, Y: 0}),
+		NewFlatHexLayout(Vec2{X: 10, Y: 10}, Vec2{X: 0, Y: 0}),
+	}
+
+	for _, layout := range layouts {
+		for i, edge := range loop {
+			next := loop[(i+1)%len(loop)]
+			_, end := HexEdgeVertices(HexVertices(layout, edge.Coord, 10), edge.Dir, layout.Orientation)
+			start, _ := HexEdgeVertices(HexVertices(layout, next.Coord, 10), next.Dir, layout.Orientation)
+
+			dx := float64(end.X - start.X)
+			dy := float64(end.Y - start.Y)
+			if math.Sqrt(dx*dx+dy*dy) > 0.001 {
+				t.Errorf("orientation %d: edge %d %v ends at %v but edge %d %v starts at %v",
+					layout.Orientation, i, edge, end, (i+1)%len(loop), next, start)
+			}
+		}
+	}
+}
+
+func TestTraceBoundary_Radius1(t *testing.T) {
+	grid := NewHexGrid[int](1)
+
+	loops := TraceBoundary(grid, nil)
+	if len(loops) != 1 {
+		t.Fatalf("TraceBoundary returned %d loops, want 1", len(loops))
+	}
+	if len(loops[0]) != 18 {
+		t.Errorf("loop has %d edges, want 18", len(loops[0]))
+	}
+
+	checkLoopConnected(t, loops[0])
+
+	// Every boundary edge appears exactly once
+	seen := make(map[HexEdge]bool)
+	for _, edge := range loops[0] {
+		if seen[edge] {
+			t.Errorf("edge %v appears more than once", edge)
+		}
+		seen[edge] = true
+	}
+	for _, edge := range BoundaryEdges(grid) {
+		if !seen[edge] {
+			t.Errorf("boundary edge %v missing from loop", edge)
+		}
+	}
+}
+
+func TestTraceBoundary_Hole(t *testing.T) {
+	grid := NewHexGrid[int](2)
+	center := HexCoord{Q: 0, R: 0}
+
+	loops := TraceBoundary(grid, func(coord HexCoord) bool {
+		return coord != center
+	})
+	if len(loops) != 2 {
+		t.Fatalf("TraceBoundary returned %d loops, want 2", len(loops))
+	}
+
+	lengths := map[int]bool{len(loops[0]): true, len(loops[1]): true}
+	if !lengths[30] || !lengths[6] {
+		t.Errorf("loop lengths = %d and %d, want 30 and 6", len(loops[0]), len(loops[1]))
+	}
+
+	for _, loop := range loops {
+		checkLoopConnected(t, loop)
+	}
+}
+
+func TestTraceBoundary_SeparateRegions(t *testing.T) {
+	grid := NewHexGrid[int](3)
+	a := HexCoord{Q: -2, R: 0}
+	b := HexCoord{Q: 2, R: 0}
+
+	loops := TraceBoundary(grid, func(coord HexCoord) bool {
+		return coord == a || coord == b
+	})
+	if len(loops) != 2 {
+		t.Fatalf("TraceBoundary returned %d loops, want 2", len(loops))
+	}
+	for _, loop := range loops {
+		if len(loop) != 6 {
+			t.Errorf("single-cell loop has %d edges, want 6", len(loop))
+		}
+		checkLoopConnected(t, loop)
+	}
+}
+
+func TestZoneBoundary(t *testing.T) {
+	// An L of three cells running west to east, then two more north of its
+	// eastern end
+	zone := []HexCoord{
+		{Q: 0, R: 0}, {Q: 1, R: 0}, {Q: 2, R: 0},
+		{Q: 2, R: -1}, {Q: 2, R: -2},
+	}
+
+	loop := ZoneBoundary(zone)
+	checkLoopConnected(t, loop)
+
+	// The hull is every cell edge that doesn't face another zone cell,
+	// each once: 5 cells × 6 edges less 2 for each of the 5 shared edges,
+	// counting the one between (1, 0) and (2, -1) in the bend
+	if len(loop) != 20 {
+		t.Errorf("ZoneBoundary returned %d edges, want 20", len(loop))
+	}
+	inZone := make(map[HexCoord]bool)
+	for _, coord := range zone {
+		inZone[coord] = true
+	}
+	seen := make(map[HexEdge]bool)
+	for _, edge := range loop {
+		if seen[edge] {
+			t.Errorf("edge %v appears more than once", edge)
+		}
+		seen[edge] = true
+		if !inZone[edge.Coord] || inZone[edge.Coord.Neighbor(edge.Dir)] {
+			t.Errorf("edge %v is not on the zone boundary", edge)
+		}
+	}
+
+	// A ring's hole is left out; only the outer 18 edges are traced
+	ring := HexCoord{}.Neighbors()
+	if loop := ZoneBoundary(ring[:]); len(loop) != 18 {
+		t.Errorf("ring ZoneBoundary returned %d edges, want 18", len(loop))
+	}
+
+	if loop := ZoneBoundary(nil); loop != nil {
+		t.Errorf("ZoneBoundary(nil) = %v, want nil", loop)
+	}
+}
+
+func TestHexGridRenderData_UpdateCell(t *testing.T) {
+	grid := NewHexGrid[int](2)
+	config := DefaultHexRenderConfig(10.0)
+	data := PrepareGridRenderData(grid, config)
+
+	before := make([][6]Vec3, len(data.Vertices))
+	copy(before, data.Vertices)
+
+	// Re-render one cell with a shifted layout
+	target := HexCoord{Q: 1, R: -1}
+	shifted := NewHexLayout(config.Layout.Size, Vec2{X: 5, Y: 7})
+	data.UpdateCell(shifted, config.HexRadius, target)
+
+	targetIdx, ok := data.IndexOf(target)
+	if !ok {
+		t.Fatalf("IndexOf(%v) not found", target)
+	}
+
+	for i := range data.Vertices {
+		if i == targetIdx {
+			want := HexVertices3D(shifted, target, config.HexRadius)
+			if data.Vertices[i] != want {
+				t.Errorf("updated cell vertices = %v, want %v", data.Vertices[i], want)
+			}
+			if data.Vertices[i] == before[i] {
+				t.Error("updated cell vertices should have changed")
+			}
+			continue
+		}
+		if data.Vertices[i] != before[i] {
+			t.Errorf("cell %v vertices changed, want unchanged", data.Cells[i])
+		}
+	}
+
+	// Cells outside the render data are ignored
+	data.UpdateCell(shifted, config.HexRadius, HexCoord{Q: 10, R: 10})
+	if len(data.Vertices) != len(before) {
+		t.Errorf("Vertices has %d entries, want %d", len(data.Vertices), len(before))
+	}
+}
+
+func TestHexCenter3D(t *testing.T) {
+	layout := NewHexLayout(Vec2{X: 10, Y: 10}, Vec2{X: 5, Y: -5})
+	coord := HexCoord{Q: 1, R: 2}
+
+	pixel := layout.ToPixel(coord)
+	got := HexCenter3D(layout, coord)
+	want := Vec3{X: pixel.X, Y: 0, Z: pixel.Y}
+	if got != want {
+		t.Errorf("HexCenter3D = %v, want %v", got, want)
+	}
+}
+
+func TestHexLabelScale(t *testing.T) {
+	font := newTestFont() // 11 units per glyph, 32 tall
+
+	tests := []struct {
+		name string
+		text string
+		want float32
+	}{
+		// Height bound: 0.5 * 40 / 32
+		{"short text", "ab", 0.625},
+		// Width bound: 0.8 * sqrt3 * 40 / 110
+		{"long text", "abcdefghij", 0.8 * sqrt3 * 40 / 110},
+		{"empty text", "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := HexLabelScale(font, tt.text, 40)
+			if math.Abs(float64(got-tt.want)) > 1e-5 {
+				t.Errorf("HexLabelScale(%q) = %f, want %f", tt.text, got, tt.want)
+			}
+		})
+	}
+
+	if got := HexLabelScale(nil, "ab", 40); got != 0 {
+		t.Errorf("HexLabelScale with nil font = %f, want 0", got)
+	}
+}
+
+func TestBuildHexGridMesh(t *testing.T) {
+	grid := NewHexGrid[int](1)
+	config := DefaultHexRenderConfig(10)
+	data := PrepareGridRenderData(grid, config)
+
+	hidden := HexCoord{Q: 1, R: 0}
+	fill := func(coord HexCoord) Color {
+		if coord == hidden {
+			return Color{}
+		}
+		return ColorRed
+	}
+
+	mesh := BuildHexGridMesh(data, fill)
+
+	// 7 cells, one transparent, 6 triangles each
+	if got := mesh.TriangleCount(); got != 36 {
+		t.Errorf("TriangleCount() = %d, want 36", got)
+	}
+	if got, want := len(mesh.Colors), mesh.VertexCount()*4; got != want {
+		t.Errorf("len(Colors) = %d, want %d", got, want)
+	}
+
+	// The first triangle fans from the first cell's center
+	idx, _ := data.IndexOf(data.Cells[0])
+	center := HexCenter3D(config.Layout, data.Cells[0])
+	first := Vec3{X: mesh.Vertices[0], Y: mesh.Vertices[1], Z: mesh.Vertices[2]}
+	if !vec3Near(first, center) {
+		t.Errorf("first vertex = %v, want cell center %v", first, center)
+	}
+	second := Vec3{X: mesh.Vertices[3], Y: mesh.Vertices[4], Z: mesh.Vertices[5]}
+	if !vec3Near(second, data.Vertices[idx][0]) {
+		t.Errorf("second vertex = %v, want %v", second, data.Vertices[idx][0])
+	}
+	if mesh.Colors[0] != ColorRed.R || mesh.Colors[3] != ColorRed.A {
+		t.Errorf("first vertex color = %v, want %v", mesh.Colors[:4], ColorRed)
+	}
+}
+
+func BenchmarkBuildHexGridMesh(b *testing.B) {
+	grid := NewHexGrid[int](20)
+	data := PrepareGridRenderData(grid, DefaultHexRenderConfig(10))
+	fill := func(HexCoord) Color { return ColorBlue }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		BuildHexGridMesh(data, fill)
+	}
+}
+
+func TestGridPixelBounds(t *testing.T) {
+	layout := NewHexLayout(Vec2{X: 10, Y: 10}, Vec2{X: 0, Y: 0})
+
+	// Radius 1: the E/W cells sit at x = ±10√3 and reach a further 5√3;
+	// the NE/NW and SE/SW rows sit at y = ±15 and reach a further 10
+	min, max := GridPixelBounds(NewHexGrid[int](1), layout, 10)
+	wantMin := Vec2{X: -15 * sqrt3, Y: -25}
+	wantMax := Vec2{X: 15 * sqrt3, Y: 25}
+
+	near := func(a, b Vec2) bool {
+		return math.Abs(float64(a.X-b.X)) < 1e-3 && math.Abs(float64(a.Y-b.Y)) < 1e-3
+	}
+	if !near(min, wantMin) {
+		t.Errorf("min = %v, want %v", min, wantMin)
+	}
+	if !near(max, wantMax) {
+		t.Errorf("max = %v, want %v", max, wantMax)
+	}
+
+	// A single cell is bounded by its own vertices, shifted by the origin
+	offset := NewHexLayout(Vec2{X: 10, Y: 10}, Vec2{X: 100, Y: 50})
+	min, max = GridPixelBounds(NewHexGrid[int](0), offset, 10)
+	if !near(min, Vec2{X: 100 - 5*sqrt3, Y: 40}) || !near(max, Vec2{X: 100 + 5*sqrt3, Y: 60}) {
+		t.Errorf("single cell bounds = %v..%v", min, max)
+	}
+}
+
+func TestNewHexEdge(t *testing.T) {
+	center := HexCoord{Q: 2, R: -1}
+	for dir := HexDirection(0); dir < 6; dir++ {
+		neighbor := center.Neighbor(dir)
+
+		ab, ok := NewHexEdge(center, neighbor)
+		if !ok {
+			t.Fatalf("NewHexEdge(%v, %v) reported non-neighbors", center, neighbor)
+		}
+		ba, ok := NewHexEdge(neighbor, center)
+		if !ok || ab != ba {
+			t.Errorf("NewHexEdge(%v, %v) = %v, reversed = %v", center, neighbor, ab, ba)
+		}
+		if want := normalizeEdge(center, dir); ab != want {
+			t.Errorf("NewHexEdge toward %v = %v, want %v", dir, ab, want)
+		}
+	}
+
+	for _, other := range []HexCoord{center, {Q: 4, R: -1}, {Q: 0, R: 0}} {
+		if _, ok := NewHexEdge(center, other); ok {
+			t.Errorf("NewHexEdge(%v, %v) should not find an edge", center, other)
+		}
+	}
+}
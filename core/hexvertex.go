@@ -0,0 +1,71 @@
+// Package core provides hex vertex (corner) utilities for the Spectrex framework.
+package core
+
+// HexVertex represents a corner where three hex cells meet.
+// Corners are numbered by the edges they join: corner k of a hex lies
+// between its edges in directions k and k+1, so corner 0 joins the E and NE
+// edges and corner 1 the NE and NW edges. Every corner is corner 0 or 1 of
+// exactly one of the three hexes sharing it, so, like HexEdge, a vertex is
+// uniquely identified by that hex and corner.
+type HexVertex struct {
+	Coord  HexCoord // The hex this vertex belongs to
+	Corner int      // Corner of the hex (0 or 1 only)
+}
+
+// NewHexVertex returns the canonical vertex for corner 0-5 of a hex.
+func NewHexVertex(coord HexCoord, corner int) HexVertex {
+	corner = ((corner % 6) + 6) % 6
+	switch corner {
+	case 0, 1:
+		return HexVertex{Coord: coord, Corner: corner}
+	case 2, 3:
+		// Corner k is corner k+4 of the neighbor in direction k+1
+		return HexVertex{Coord: coord.Neighbor(HexDirection(corner + 1)), Corner: corner - 2}
+	default:
+		// Corner k is corner k+2 of the neighbor in direction k
+		return HexVertex{Coord: coord.Neighbor(HexDirection(corner)), Corner: corner - 4}
+	}
+}
+
+// CellVertices returns the six vertices of a hex, counter-clockwise in
+// direction order starting with the corner between its E and NE edges.
+func CellVertices(coord HexCoord) [6]HexVertex {
+	var vertices [6]HexVertex
+	for i := range vertices {
+		vertices[i] = NewHexVertex(coord, i)
+	}
+	return vertices
+}
+
+// VertexNeighbors returns the three vertices joined to v by an edge.
+func VertexNeighbors(v HexVertex) [3]HexVertex {
+	// Two neighbors are the adjacent corners of v's own hex; the third is
+	// at the far end of the edge between the other two hexes, which is
+	// corner Corner+1 of the neighbor in direction Corner
+	return [3]HexVertex{
+		NewHexVertex(v.Coord, v.Corner-1),
+		NewHexVertex(v.Coord, v.Corner+1),
+		NewHexVertex(v.Coord.Neighbor(HexDirection(v.Corner)), v.Corner+1),
+	}
+}
+
+// Cells returns the three hexes that meet at the vertex.
+func (v HexVertex) Cells() [3]HexCoord {
+	return [3]HexCoord{
+		v.Coord,
+		v.Coord.Neighbor(HexDirection(v.Corner)),
+		v.Coord.Neighbor(HexDirection(v.Corner + 1)),
+	}
+}
+
+// HexVertexPosition returns the pixel position of a vertex, matching the
+// corresponding point of HexVertices.
+func HexVertexPosition(layout HexLayout, v HexVertex, radius float32) Vec2 {
+	// HexVertices numbers clockwise from the vertex shared by the E and NE
+	// edges on pointy-top hexes, and from the one before it on flat-top
+	first := 1
+	if layout.Orientation == HexFlatTop {
+		first = 0
+	}
+	return HexVertices(layout, v.Coord, radius)[((first-v.Corner)%6+6)%6]
+}
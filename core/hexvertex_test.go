@@ -0,0 +1,100 @@
+package core
+
+import "testing"
+
+// vec2Near reports whether two points match to within float error.
+func vec2Near(a, b Vec2) bool {
+	dx, dy := a.X-b.X, a.Y-b.Y
+	return dx*dx+dy*dy < 1e-6
+}
+
+func TestHexVertex_SharedByThreeHexes(t *testing.T) {
+	// The hexes at (0,0), its E neighbor and its NE neighbor meet at a point
+	a := HexCoord{Q: 0, R: 0}
+	b := a.Neighbor(HexDirE)
+	c := a.Neighbor(HexDirNE)
+
+	want := HexVertex{Coord: a, Corner: 0}
+	for _, coord := range []HexCoord{a, b, c} {
+		vertices := CellVertices(coord)
+		count := 0
+		for _, v := range vertices {
+			if v == want {
+				count++
+			}
+		}
+		if count != 1 {
+			t.Errorf("CellVertices(%v) contains %v %d times, want once", coord, want, count)
+		}
+	}
+
+	// Neighboring hexes share the two ends of their common edge
+	shared := 0
+	for _, v := range CellVertices(a) {
+		for _, w := range CellVertices(b) {
+			if v == w {
+				shared++
+			}
+		}
+	}
+	if shared != 2 {
+		t.Errorf("%v and %v share %d vertices, want 2", a, b, shared)
+	}
+
+	cells := want.Cells()
+	if cells != [3]HexCoord{a, b, c} {
+		t.Errorf("Cells() = %v, want %v", cells, [3]HexCoord{a, b, c})
+	}
+}
+
+func TestHexVertex_Positions(t *testing.T) {
+	layouts := []HexLayout{
+		NewHexLayout(Vec2{X: 10, Y: 10}, Vec2{}),
+		NewFlatHexLayout(Vec2{X: 10, Y: 10}, Vec2{}),
+	}
+	center := HexCoord{Q: 1, R: -2}
+
+	for _, layout := range layouts {
+		// Every corner lands on a HexVertices point of each hex sharing it
+		for corner, v := range CellVertices(center) {
+			if v.Corner != 0 && v.Corner != 1 {
+				t.Fatalf("corner %d is not canonical: %v", corner, v)
+			}
+			pos := HexVertexPosition(layout, v, 10)
+			for _, cell := range v.Cells() {
+				found := false
+				for _, p := range HexVertices(layout, cell, 10) {
+					found = found || vec2Near(p, pos)
+				}
+				if !found {
+					t.Errorf("orientation %v corner %d: %v is not a vertex of %v", layout.Orientation, corner, pos, cell)
+				}
+			}
+		}
+
+		// Neighbors are one edge length away
+		for _, v := range CellVertices(center) {
+			pos := HexVertexPosition(layout, v, 10)
+			for _, n := range VertexNeighbors(v) {
+				d := HexVertexPosition(layout, n, 10)
+				dx, dy := d.X-pos.X, d.Y-pos.Y
+				if dist := dx*dx + dy*dy; dist < 99.9 || dist > 100.1 {
+					t.Errorf("orientation %v: neighbor %v of %v is %g² away, want 10²", layout.Orientation, n, v, dist)
+				}
+			}
+		}
+	}
+
+	// Consecutive corners follow HexEdgeVertices around the hex
+	layout := layouts[0]
+	vertices := HexVertices(layout, center, 10)
+	for dir := HexDirection(0); dir < 6; dir++ {
+		from, to := HexEdgeVertices(vertices, dir, layout.Orientation)
+		corners := CellVertices(center)
+		start := HexVertexPosition(layout, corners[(int(dir)+5)%6], 10)
+		end := HexVertexPosition(layout, corners[dir], 10)
+		if !(vec2Near(from, start) && vec2Near(to, end)) && !(vec2Near(from, end) && vec2Near(to, start)) {
+			t.Errorf("edge %v runs %v-%v, corners give %v-%v", dir, from, to, start, end)
+		}
+	}
+}
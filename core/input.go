@@ -0,0 +1,138 @@
+// Package core provides the backend-agnostic input interface for the
+// Spectrex framework.
+package core
+
+// Key identifies a keyboard key. Letters and digits are their uppercase
+// ASCII codes, so Key('A') is the A key; the other codes follow GLFW, which
+// raylib shares.
+type Key int32
+
+// Keys without a printable ASCII code.
+const (
+	KeySpace     Key = 32
+	KeyEscape    Key = 256
+	KeyEnter     Key = 257
+	KeyTab       Key = 258
+	KeyBackspace Key = 259
+	KeyDelete    Key = 261
+	KeyRight     Key = 262
+	KeyLeft      Key = 263
+	KeyDown      Key = 264
+	KeyUp        Key = 265
+	KeyPageUp    Key = 266
+	KeyPageDown  Key = 267
+	KeyHome      Key = 268
+	KeyEnd       Key = 269
+	KeyF1        Key = 290
+	KeyShift     Key = 340 // Left shift
+	KeyControl   Key = 341 // Left control
+	KeyAlt       Key = 342 // Left alt
+)
+
+// MouseButton identifies a mouse button.
+type MouseButton int32
+
+// Mouse buttons.
+const (
+	MouseLeft MouseButton = iota
+	MouseRight
+	MouseMiddle
+)
+
+// Input reads the keyboard, mouse and frame clock, so application and
+// widget logic can run on any backend, or against a MockInput in tests.
+// "Pressed" means the key or button went down since the previous frame.
+type Input interface {
+	IsKeyPressed(key Key) bool
+	IsKeyDown(key Key) bool
+
+	// MousePosition is in window pixels, from the top left.
+	MousePosition() Vec2
+	IsMouseButtonPressed(button MouseButton) bool
+	IsMouseButtonDown(button MouseButton) bool
+	// MouseWheel is how far the wheel turned this frame; positive is away
+	// from the user.
+	MouseWheel() float32
+
+	// FrameTime is the duration of the last frame in seconds.
+	FrameTime() float32
+}
+
+// MockInput is an Input whose state is set directly, for tests and for
+// backends without input such as SVG. The zero value reports no input.
+type MockInput struct {
+	Down    map[Key]bool
+	Pressed map[Key]bool
+
+	Mouse          Vec2
+	ButtonsDown    map[MouseButton]bool
+	ButtonsPressed map[MouseButton]bool
+	Wheel          float32
+
+	DeltaTime float32
+}
+
+// Press marks key as pressed this frame and held down.
+func (m *MockInput) Press(key Key) {
+	if m.Down == nil {
+		m.Down = make(map[Key]bool)
+	}
+	if m.Pressed == nil {
+		m.Pressed = make(map[Key]bool)
+	}
+	m.Down[key] = true
+	m.Pressed[key] = true
+}
+
+// Release lets go of key.
+func (m *MockInput) Release(key Key) {
+	delete(m.Down, key)
+	delete(m.Pressed, key)
+}
+
+// Click marks button as pressed this frame and held down, with the mouse at
+// position.
+func (m *MockInput) Click(button MouseButton, position Vec2) {
+	if m.ButtonsDown == nil {
+		m.ButtonsDown = make(map[MouseButton]bool)
+	}
+	if m.ButtonsPressed == nil {
+		m.ButtonsPressed = make(map[MouseButton]bool)
+	}
+	m.Mouse = position
+	m.ButtonsDown[button] = true
+	m.ButtonsPressed[button] = true
+}
+
+// NextFrame ends the frame: presses and wheel movement are cleared, while
+// keys and buttons stay held until released.
+func (m *MockInput) NextFrame() {
+	m.Pressed = nil
+	m.ButtonsPressed = nil
+	m.Wheel = 0
+}
+
+// IsKeyPressed reports whether key was pressed this frame.
+func (m *MockInput) IsKeyPressed(key Key) bool { return m.Pressed[key] }
+
+// IsKeyDown reports whether key is held down.
+func (m *MockInput) IsKeyDown(key Key) bool { return m.Down[key] }
+
+// MousePosition returns Mouse.
+func (m *MockInput) MousePosition() Vec2 { return m.Mouse }
+
+// IsMouseButtonPressed reports whether button was pressed this frame.
+func (m *MockInput) IsMouseButtonPressed(button MouseButton) bool {
+	return m.ButtonsPressed[button]
+}
+
+// IsMouseButtonDown reports whether button is held down.
+func (m *MockInput) IsMouseButtonDown(button MouseButton) bool {
+	return m.ButtonsDown[button]
+}
+
+// MouseWheel returns Wheel.
+func (m *MockInput) MouseWheel() float32 { return m.Wheel }
+
+// FrameTime returns DeltaTime.
+func (m *MockInput) FrameTime() float32 { return m.DeltaTime }
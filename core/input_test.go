@@ -0,0 +1,100 @@
+package core
+
+import "testing"
+
+// testMenu is a minimal widget driven by Input: up and down move the
+// selection, holding down repeats every quarter second, the wheel scrolls,
+// and Enter or a left click chooses the selected item.
+type testMenu struct {
+	items    []string
+	selected int
+	chosen   string
+	held     float32
+}
+
+func (m *testMenu) update(input Input) {
+	move := 0
+	switch {
+	case input.IsKeyPressed(KeyUp):
+		move = -1
+	case input.IsKeyPressed(KeyDown):
+		move = 1
+		m.held = 0
+	case input.IsKeyDown(KeyDown):
+		m.held += input.FrameTime()
+		if m.held >= 0.25 {
+			m.held -= 0.25
+			move = 1
+		}
+	}
+	if wheel := input.MouseWheel(); wheel != 0 {
+		move = -int(wheel)
+	}
+	m.selected = max(0, min(len(m.items)-1, m.selected+move))
+
+	if input.IsKeyPressed(KeyEnter) || input.IsMouseButtonPressed(MouseLeft) {
+		m.chosen = m.items[m.selected]
+	}
+}
+
+func TestMockInput_DrivesWidget(t *testing.T) {
+	menu := &testMenu{items: []string{"new", "load", "options", "quit"}}
+	input := &MockInput{DeltaTime: 0.1}
+
+	// Nothing happens without input
+	menu.update(input)
+	if menu.selected != 0 || menu.chosen != "" {
+		t.Fatalf("idle menu selected %d, chose %q", menu.selected, menu.chosen)
+	}
+
+	// A press moves once; a brief hold afterwards doesn't repeat
+	input.Press(KeyDown)
+	menu.update(input)
+	input.NextFrame()
+	menu.update(input)
+	if menu.selected != 1 {
+		t.Errorf("after pressing down, selected %d, want 1", menu.selected)
+	}
+
+	// Holding repeats: 0.1s has passed, 0.2s more reaches the quarter second
+	menu.update(input)
+	menu.update(input)
+	if menu.selected != 2 {
+		t.Errorf("after holding down, selected %d, want 2", menu.selected)
+	}
+	input.Release(KeyDown)
+	menu.update(input)
+	menu.update(input)
+	menu.update(input)
+	if menu.selected != 2 {
+		t.Errorf("after releasing down, selected %d, want 2", menu.selected)
+	}
+
+	// The wheel scrolls, clamped to the items
+	input.Wheel = -5
+	menu.update(input)
+	input.NextFrame()
+	if menu.selected != 3 {
+		t.Errorf("after scrolling, selected %d, want 3", menu.selected)
+	}
+
+	input.Press(KeyUp)
+	input.Press(KeyEnter)
+	menu.update(input)
+	input.NextFrame()
+	if menu.selected != 2 || menu.chosen != "options" {
+		t.Errorf("after up and enter, selected %d and chose %q, want 2 and options", menu.selected, menu.chosen)
+	}
+
+	// A click chooses too; the press lasts only its frame
+	menu.chosen = ""
+	input.Click(MouseLeft, Vec2{X: 40, Y: 30})
+	menu.update(input)
+	if menu.chosen != "options" || input.MousePosition() != (Vec2{X: 40, Y: 30}) {
+		t.Errorf("after click, chose %q with mouse at %v", menu.chosen, input.MousePosition())
+	}
+	input.NextFrame()
+	if input.IsMouseButtonPressed(MouseLeft) || !input.IsMouseButtonDown(MouseLeft) {
+		t.Error("NextFrame should clear the click but keep the button held")
+	}
+}
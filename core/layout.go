@@ -4,7 +4,12 @@
 package core
 
 import (
+	"math"
+	"slices"
+	"sort"
 	"strings"
+	"sync"
+	"unicode/utf8"
 )
 
 // TextAlign defines text alignment options within a text region.
@@ -26,10 +31,75 @@ const (
 	AlignBottom
 )
 
+// BillboardMode controls whether a TextScreen turns to face the viewer.
+type BillboardMode int
+
+const (
+	// BillboardNone keeps the screen at its fixed Rotation.
+	BillboardNone BillboardMode = iota
+	// BillboardYaw turns the screen about the vertical axis only, so it
+	// stays upright as the viewer moves around it.
+	BillboardYaw
+	// BillboardSpherical turns the screen to face the viewer fully,
+	// tilting it when they look from above or below.
+	BillboardSpherical
+)
+
+// BackgroundMode selects how an opaque TextScreen's background is drawn.
+type BackgroundMode int
+
+const (
+	// BackgroundSolid fills the screen with BackgroundColor.
+	BackgroundSolid BackgroundMode = iota
+	// BackgroundWireframe draws the edges of the two triangles that would
+	// fill the screen.
+	BackgroundWireframe
+	// BackgroundScanlines draws horizontal lines ScanlineGap apart; see
+	// TextScreen.Scanlines.
+	BackgroundScanlines
+)
+
+// Default TextScreen Z offsets, small enough to be invisible at normal
+// viewing distances but large enough to separate the planes in the depth
+// buffer.
+const (
+	DefaultTextZOffset   = 0.05
+	DefaultBorderZOffset = 0.025
+)
+
+// DefaultScanlineGap is the spacing of scanline backgrounds that leave
+// ScanlineGap unset.
+const DefaultScanlineGap = 4
+
+// TextDirection defines how text flows within a text region.
+type TextDirection int
+
+const (
+	// DirectionLeftToRight lays text out in horizontal lines.
+	DirectionLeftToRight TextDirection = iota
+	// DirectionTopToBottom stacks one glyph per row, advancing downward.
+	DirectionTopToBottom
+	// DirectionRightToLeft lays text out in horizontal lines read from
+	// right to left: each line's first character is at its right end.
+	DirectionRightToLeft
+)
+
+// EllipsisMode selects where a SingleLine region cuts text that is too
+// wide, putting its OverflowMarker in place of the removed characters.
+type EllipsisMode int
+
+const (
+	// EllipsisEnd keeps the start of the text: "status: conn...".
+	EllipsisEnd EllipsisMode = iota
+	// EllipsisMiddle keeps both ends: "file...ension".
+	EllipsisMiddle
+)
+
 // TextScreen represents a virtual 2D screen in 3D space for organizing text and regions.
 type TextScreen struct {
 	Position        Vec3
 	Rotation        Vec3
+	Billboard       BillboardMode // When set, replaces Rotation to face the camera
 	Width           float32
 	Height          float32
 	Scale           float32
@@ -39,33 +109,197 @@ type TextScreen struct {
 	BorderColor     Color
 	BackgroundColor Color
 	Debug           bool
+
+	// BackgroundMode and ScanlineGap style the background drawn when the
+	// screen is not Transparent.
+	BackgroundMode BackgroundMode
+	ScanlineGap    float32
+
+	// TextZOffset and BorderZOffset lift text and borders this far off the
+	// background plane toward the readable side (local +Z), so they do not
+	// z-fight with the backgrounds behind them.
+	TextZOffset   float32
+	BorderZOffset float32
+
+	// Parent, if set, makes Position and Rotation relative to another
+	// screen, so moving or rotating the parent carries this screen with it.
+	// A billboarded screen follows only its parent's position. Set it with
+	// SetParent, which refuses cycles; a cycle set directly is cut where it
+	// loops back.
+	Parent *TextScreen
+}
+
+// TextSpan colors a range of runes within a TextRegion's Text.
+type TextSpan struct {
+	Start int   // Rune index of the first character in the span
+	End   int   // Rune index one past the last character in the span
+	Color Color // Color of the characters in the span
 }
 
 // TextRegion represents a rectangular area within a TextScreen for text layout.
 type TextRegion struct {
-	X               float32
-	Y               float32
-	Width           float32
-	Height          float32
-	Text            string
-	Font            *HersheyFont
-	Color           Color
-	Scale           float32
-	LineSpacing     float32
-	CharSpacing     float32
-	HAlign          TextAlign
-	VAlign          VerticalAlign
-	WordWrap        bool
-	MaxLines        int
+	X                float32
+	Y                float32
+	Width            float32
+	Height           float32
+	Text             string
+	Font             *HersheyFont
+	Color            Color
+	Scale            float32
+	LineSpacing      float32
+	CharSpacing      float32
+	WordSpacing      float32 // Extra advance after each space, in font units
+	HAlign           TextAlign
+	VAlign           VerticalAlign
+	WordWrap         bool
+	BreakLongWords   bool
+	TabWidth         float32 // Tab stop interval in space widths; 0 disables tabs
+	MaxLines         int
 	TruncateOverflow bool
-	OverflowMarker  string
-	Transparent     bool
-	ShowBorder      bool
-	BorderColor     Color
-	BackgroundColor Color
-	Parent          *TextScreen
+	OverflowMarker   string
+	Transparent      bool
+	ShowBorder       bool
+	BorderColor      Color
+	BackgroundColor  Color
+	Parent           *TextScreen
+
+	// Padding insets the text from every edge of the region. Backgrounds
+	// and borders still cover the full X/Y/Width/Height rect.
+	Padding float32
+
+	// ScrollOffset shifts the text upward by this many world units,
+	// revealing lines below the bottom of the region.
+	ScrollOffset float32
+
+	// Spans override Color for ranges of Text. Later spans take precedence
+	// where they overlap.
+	Spans []TextSpan
+
+	// Underline and Strikethrough draw a line in the text color under or
+	// through each rendered line, spanning the line's measured width.
+	Underline     bool
+	Strikethrough bool
+
+	// Bold thickens glyphs by drawing each stroke DefaultBoldWeight to
+	// either side as well; see GlyphStrokes.
+	Bold bool
+
+	// RoundCaps draws a disc at the ends and joints of glyph strokes drawn
+	// with a thickness, closing the gaps where thick strokes meet and
+	// rounding their ends, for large display text. Off by default as it
+	// adds a disc per stroke end; see StrokeCaps. SVG output always rounds
+	// its strokes.
+	RoundCaps bool
+
+	// Dashed draws each glyph stroke as dashes DashLength font units long
+	// separated by DashGap, for a dotted or holographic look. Zero lengths
+	// use DefaultTextDashLength and DefaultTextDashGap.
+	Dashed     bool
+	DashLength float32
+	DashGap    float32
+
+	// JustifyLastLine stretches the last line of a justified region to the
+	// full width like the others. Lines that are not stretched, including
+	// single-word lines, are placed by JustifyFallback, which may be
+	// AlignLeft (the default), AlignCenter or AlignRight.
+	JustifyLastLine bool
+	JustifyFallback TextAlign
+
+	// ClipText clips glyph strokes and decorations to the region rect, so
+	// lines partly scrolled out of view are cut at the edge. Otherwise a
+	// line is drawn whole or, when its origin is outside the region, not
+	// at all.
+	ClipText bool
+
+	// Direction selects how text flows. With DirectionRightToLeft each
+	// line reads from its right end; see VisualOffsets. With
+	// DirectionTopToBottom each glyph is its own row, one line height
+	// apart, and HAlign positions each glyph within the row. Newlines are
+	// skipped and WordWrap is ignored.
+	Direction TextDirection
+
+	// SingleLine shows Text as one line, for labels and status text:
+	// newlines become spaces, WordWrap and MaxLines are ignored, and text
+	// wider than the region is cut where Ellipsis says and marked with
+	// OverflowMarker. It has no effect on DirectionTopToBottom.
+	SingleLine bool
+	Ellipsis   EllipsisMode
+
+	// LODDistance and LODPixelSize simplify distant text: beyond
+	// LODDistance world units from the viewer, or when a line would be
+	// less than LODPixelSize pixels tall, renderers draw each line as a
+	// solid box in the text color instead of its glyphs. Zero disables
+	// each threshold.
+	LODDistance  float32
+	LODPixelSize float32
+
+	// Memoized CalculateLineWidth results, locked so several goroutines
+	// can measure the region at once. The lock makes regions unsafe to
+	// copy by value, as a copy would share the cache's map.
+	widthsMu sync.Mutex
+	widths   lineWidthCache
 }
 
+// lineWidthKey identifies a memoized CalculateLineWidth result.
+type lineWidthKey struct {
+	line  string
+	scale float32
+}
+
+// lineWidthCache holds line widths along with the region settings they
+// were measured with, so changing those settings discards them.
+type lineWidthCache struct {
+	font        *HersheyFont
+	charSpacing float32
+	wordSpacing float32
+	tabWidth    float32
+	widths      map[lineWidthKey]float32
+}
+
+// Dash pattern, in font units, for Dashed regions that leave it unset.
+const (
+	DefaultTextDashLength = 3
+	DefaultTextDashGap    = 2
+)
+
+// maxCachedLineWidths bounds the line width cache; it is emptied when full,
+// so wrapping long text at many scales cannot grow it without limit.
+const maxCachedLineWidths = 1024
+
+// TextDecoration is a horizontal underline or strikethrough segment in the
+// screen's local space. StartX is the line start as returned by
+// CalculateLineX; the segment runs toward lower X, matching the glyphs.
+type TextDecoration struct {
+	Y      float32
+	StartX float32
+	EndX   float32
+}
+
+// TextBox is a rectangle in the screen's local space, with X and Y at its
+// lowest corner, drawn in place of a line of text; see TextRegion.LineBox.
+type TextBox struct {
+	X      float32
+	Y      float32
+	Width  float32
+	Height float32
+}
+
+// Decoration offsets from a line's origin, as fractions of the font height.
+// Hershey glyphs are centered on the origin with the baseline 9/32 below it:
+// the underline sits where the underscore glyph does, and the strikethrough
+// crosses the middle of the lowercase letters.
+const (
+	underlineOffset     = -11.0 / 32
+	strikethroughOffset = -2.0 / 32
+)
+
+// Line box extent from a line's origin, as fractions of the font height:
+// from the baseline to the top of the capitals.
+const (
+	lineBoxBottom = -9.0 / 32
+	lineBoxTop    = 12.0 / 32
+)
+
 // NewTextScreen creates a new virtual screen for text layout in 3D space.
 func NewTextScreen(position Vec3, width, height, scale float32) *TextScreen {
 	return &TextScreen{
@@ -79,6 +313,8 @@ func NewTextScreen(position Vec3, width, height, scale float32) *TextScreen {
 		ShowBorder:      false,
 		BorderColor:     ColorWhite,
 		BackgroundColor: ColorBlack,
+		TextZOffset:     DefaultTextZOffset,
+		BorderZOffset:   DefaultBorderZOffset,
 	}
 }
 
@@ -95,6 +331,8 @@ func (ts *TextScreen) AddRegion(x, y, width, height float32) *TextRegion {
 		HAlign:           AlignLeft,
 		VAlign:           AlignTop,
 		WordWrap:         true,
+		BreakLongWords:   false,
+		TabWidth:         4,
 		TruncateOverflow: false,
 		OverflowMarker:   "...",
 		Transparent:      true,
@@ -123,6 +361,27 @@ func (ts *TextScreen) SetBackground(color Color) {
 	ts.BackgroundColor = color
 }
 
+// Scanlines returns the local Y of each line of a BackgroundScanlines
+// background, bottom to top: as many lines as fit ScanlineGap apart,
+// centered on the screen's height, and at least one if it has any height.
+func (ts *TextScreen) Scanlines() []float32 {
+	if ts.Height <= 0 {
+		return nil
+	}
+	gap := ts.ScanlineGap
+	if gap <= 0 {
+		gap = DefaultScanlineGap
+	}
+
+	count := max(int(ts.Height/gap), 1)
+	start := (ts.Height - float32(count-1)*gap) / 2
+	ys := make([]float32, count)
+	for i := range ys {
+		ys[i] = start + float32(i)*gap
+	}
+	return ys
+}
+
 // SetDebug enables or disables debug visualization of regions.
 func (ts *TextScreen) SetDebug(debug bool) {
 	ts.Debug = debug
@@ -130,14 +389,168 @@ func (ts *TextScreen) SetDebug(debug bool) {
 
 // GetTransformMatrix calculates the screen's transformation matrix.
 func (ts *TextScreen) GetTransformMatrix() Matrix {
+	return ts.transform(nil, nil)
+}
+
+// GetViewTransformMatrix calculates the screen's transformation matrix when
+// seen from viewPoint, which differs from GetTransformMatrix only for
+// billboarded screens and their children.
+func (ts *TextScreen) GetViewTransformMatrix(viewPoint Vec3) Matrix {
+	return ts.transform(&viewPoint, nil)
+}
+
+// SetParent attaches the screen to parent, or detaches it if parent is
+// nil. Returns false, leaving Parent unchanged, if parent is the screen
+// itself or one of its descendants.
+func (ts *TextScreen) SetParent(parent *TextScreen) bool {
+	var seen []*TextScreen
+	for p := parent; p != nil && !slices.Contains(seen, p); p = p.Parent {
+		if p == ts {
+			return false
+		}
+		seen = append(seen, p)
+	}
+	ts.Parent = parent
+	return true
+}
+
+// WorldPosition returns Position in world space, after the parents'
+// transforms.
+func (ts *TextScreen) WorldPosition() Vec3 {
+	return ts.parentFrame(nil, nil).TransformVec3(ts.Position)
+}
+
+// transform builds the screen's transformation matrix, seen from viewPoint
+// unless it is nil, composed with its parents'. seen holds the screens
+// below this one in the hierarchy, so a cycle of parents is cut.
+func (ts *TextScreen) transform(viewPoint *Vec3, seen []*TextScreen) Matrix {
+	frame := ts.parentFrame(viewPoint, seen)
+	if viewPoint != nil && ts.Billboard != BillboardNone {
+		position := frame.TransformVec3(ts.Position)
+		return screenMatrix(position, ts.viewRotationAt(position, *viewPoint))
+	}
+	return screenMatrix(ts.Position, ts.Rotation).Multiply(frame)
+}
+
+// parentFrame returns the matrix from the screen's parent space to world
+// space: the parent's transform without the 180° turn that puts its
+// readable side toward local +Z, so a child with no rotation faces the same
+// way as its parent. Root screens get the identity.
+func (ts *TextScreen) parentFrame(viewPoint *Vec3, seen []*TextScreen) Matrix {
+	seen = append(seen, ts)
+	if ts.Parent == nil || slices.Contains(seen, ts.Parent) {
+		return MatrixIdentity()
+	}
+	return MatrixRotateY(Pi).Multiply(ts.Parent.transform(viewPoint, seen))
+}
+
+// screenMatrix builds the transformation matrix of a screen at position
+// with the given rotation.
+func screenMatrix(position, rotation Vec3) Matrix {
 	model := MatrixIdentity()
-	model = MatrixRotateX(DegToRad(ts.Rotation.X))
-	model = model.Multiply(MatrixRotateY(DegToRad(ts.Rotation.Y + 180.0)))
-	model = model.Multiply(MatrixRotateZ(DegToRad(ts.Rotation.Z)))
-	model = model.Multiply(MatrixTranslate(ts.Position.X, ts.Position.Y, ts.Position.Z))
+	model = MatrixRotateX(DegToRad(rotation.X))
+	model = model.Multiply(MatrixRotateY(DegToRad(rotation.Y + 180.0)))
+	model = model.Multiply(MatrixRotateZ(DegToRad(rotation.Z)))
+	model = model.Multiply(MatrixTranslate(position.X, position.Y, position.Z))
 	return model
 }
 
+// ViewRotation returns the rotation, in degrees, the screen is drawn with
+// when seen from viewPoint. A billboarded screen is rotated about its
+// WorldPosition so its readable side faces viewPoint; otherwise, or when
+// viewPoint is at that position, this is Rotation.
+func (ts *TextScreen) ViewRotation(viewPoint Vec3) Vec3 {
+	return ts.viewRotationAt(ts.WorldPosition(), viewPoint)
+}
+
+// viewRotationAt is ViewRotation for the screen placed at position.
+func (ts *TextScreen) viewRotationAt(position, viewPoint Vec3) Vec3 {
+	if ts.Billboard == BillboardNone {
+		return ts.Rotation
+	}
+
+	dir := viewPoint.Sub(position)
+	if ts.Billboard == BillboardYaw {
+		dir.Y = 0
+	}
+	if dir.Length() == 0 {
+		return ts.Rotation
+	}
+
+	// The readable side is local +Z, and the transform adds 180 to the yaw
+	rotation := LookAtRotation(Vec3{}, dir)
+	rotation.Y -= 180
+	return rotation
+}
+
+// Center returns the world-space position of the middle of the screen.
+func (ts *TextScreen) Center() Vec3 {
+	return ts.GetTransformMatrix().TransformVec3(Vec3{X: ts.Width / 2, Y: ts.Height / 2})
+}
+
+// HitTestRay intersects the ray from origin along dir with the screen and
+// returns the topmost region containing the hit, with the hit's position in
+// the screen's local coordinates, as regions are laid out. ok is false if
+// the ray misses the screen; region is nil if it hits the screen but no
+// region. Billboarded screens are tested as drawn facing origin.
+func (ts *TextScreen) HitTestRay(origin, dir Vec3) (region *TextRegion, local Vec2, ok bool) {
+	model := ts.GetViewTransformMatrix(origin)
+	inverse, ok := model.Inverse()
+	if !ok {
+		return nil, Vec2{}, false
+	}
+
+	// The screen lies in its local Z = 0 plane
+	center := model.TransformVec3(Vec3{})
+	normal := model.TransformVec3(Vec3{Z: 1}).Sub(center)
+	hit, ok := Ray{Origin: origin, Direction: dir}.IntersectPlane(center, normal)
+	if !ok {
+		return nil, Vec2{}, false
+	}
+
+	p := inverse.TransformVec3(hit)
+	local = Vec2{X: p.X, Y: p.Y}
+	if local.X < 0 || local.X > ts.Width || local.Y < 0 || local.Y > ts.Height {
+		return nil, Vec2{}, false
+	}
+
+	// Later regions are drawn over earlier ones
+	for i := len(ts.Regions) - 1; i >= 0; i-- {
+		if ts.Regions[i].Contains(local) {
+			return ts.Regions[i], local, true
+		}
+	}
+	return nil, local, true
+}
+
+// SortScreensByDepth returns screens in the order they should be drawn when
+// viewed from viewPoint: opaque screens first, nearest to farthest, then
+// transparent screens farthest to nearest so they blend over what is
+// behind them. Screens at equal depth keep their relative order.
+// Billboarded screens are measured as drawn facing viewPoint.
+func SortScreensByDepth(screens []*TextScreen, viewPoint Vec3) []*TextScreen {
+	sorted := make([]*TextScreen, len(screens))
+	copy(sorted, screens)
+
+	depth := make(map[*TextScreen]float32, len(screens))
+	for _, screen := range screens {
+		center := screen.GetViewTransformMatrix(viewPoint).TransformVec3(Vec3{X: screen.Width / 2, Y: screen.Height / 2})
+		depth[screen] = center.Sub(viewPoint).Length()
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.Transparent != b.Transparent {
+			return !a.Transparent
+		}
+		if a.Transparent {
+			return depth[a] > depth[b]
+		}
+		return depth[a] < depth[b]
+	})
+	return sorted
+}
+
 // SetContent sets the content and styling for a text region.
 func (tr *TextRegion) SetContent(text string, font *HersheyFont, color Color) {
 	tr.Text = text
@@ -145,6 +558,28 @@ func (tr *TextRegion) SetContent(text string, font *HersheyFont, color Color) {
 	tr.Color = color
 }
 
+// AddSpan colors the runes of Text in [start, end) with the given color.
+func (tr *TextRegion) AddSpan(start, end int, color Color) {
+	tr.Spans = append(tr.Spans, TextSpan{Start: start, End: end, Color: color})
+}
+
+// ClearSpans removes all color spans, so the whole text uses Color.
+func (tr *TextRegion) ClearSpans() {
+	tr.Spans = nil
+}
+
+// ColorAt returns the color of the rune at the given index in Text,
+// taking spans into account.
+func (tr *TextRegion) ColorAt(index int) Color {
+	for i := len(tr.Spans) - 1; i >= 0; i-- {
+		span := tr.Spans[i]
+		if index >= span.Start && index < span.End {
+			return span.Color
+		}
+	}
+	return tr.Color
+}
+
 // SetAlignment sets the horizontal and vertical alignment for a text region.
 func (tr *TextRegion) SetAlignment(hAlign TextAlign, vAlign VerticalAlign) {
 	tr.HAlign = hAlign
@@ -173,6 +608,11 @@ func (tr *TextRegion) SetBackground(color Color) {
 	tr.BackgroundColor = color
 }
 
+// SetPadding sets the inset between the region's edges and its text.
+func (tr *TextRegion) SetPadding(padding float32) {
+	tr.Padding = padding
+}
+
 // SetOverflowHandling configures how text that doesn't fit in the region is handled.
 func (tr *TextRegion) SetOverflowHandling(truncate bool, marker string) {
 	tr.TruncateOverflow = truncate
@@ -180,65 +620,224 @@ func (tr *TextRegion) SetOverflowHandling(truncate bool, marker string) {
 }
 
 // CalculateLineWidth calculates the rendered width of a text line.
+// Widths are cached per line and scale until Font, CharSpacing, WordSpacing
+// or TabWidth changes or Invalidate is called. The cache is locked, so
+// goroutines may measure the same region concurrently.
 func (tr *TextRegion) CalculateLineWidth(line string, scale float32) float32 {
 	if tr.Font == nil {
 		return 0
 	}
 
-	totalWidth := float32(0)
+	tr.widthsMu.Lock()
+	defer tr.widthsMu.Unlock()
 
-	for _, char := range line {
-		if char < 32 || char > 126 {
-			continue
+	cache := &tr.widths
+	if cache.font != tr.Font || cache.charSpacing != tr.CharSpacing || cache.wordSpacing != tr.WordSpacing ||
+		cache.tabWidth != tr.TabWidth || len(cache.widths) >= maxCachedLineWidths {
+		*cache = lineWidthCache{
+			font:        tr.Font,
+			charSpacing: tr.CharSpacing,
+			wordSpacing: tr.WordSpacing,
+			tabWidth:    tr.TabWidth,
 		}
+	}
 
-		glyph, exists := tr.Font.Glyphs[int(char)-31]
-		if !exists {
-			totalWidth += 8 * scale
-			continue
+	key := lineWidthKey{line: line, scale: scale}
+	if width, ok := cache.widths[key]; ok {
+		return width
+	}
+
+	_, totalWidth := tr.GlyphOffsets(line, scale)
+	if cache.widths == nil {
+		cache.widths = make(map[lineWidthKey]float32)
+	}
+	cache.widths[key] = totalWidth
+	return totalWidth
+}
+
+// Invalidate discards the region's cached text measurements. Call it after
+// changing the glyphs, kerning or spacing settings of the region's Font in
+// place; changes to the region's own fields are picked up automatically.
+func (tr *TextRegion) Invalidate() {
+	tr.widthsMu.Lock()
+	defer tr.widthsMu.Unlock()
+	tr.widths = lineWidthCache{}
+}
+
+// GlyphOffsets returns the pen position of each rune in line, measured from
+// the start of the line, along with the total width of the line.
+// Tabs advance the pen to the next multiple of TabWidth space widths, and
+// pairs in the font's Kerning table are drawn closer together.
+func (tr *TextRegion) GlyphOffsets(line string, scale float32) ([]float32, float32) {
+	offsets := make([]float32, 0, len(line))
+	if tr.Font == nil {
+		for range line {
+			offsets = append(offsets, 0)
 		}
+		return offsets, 0
+	}
+
+	tabStop := float32(0)
+	if tr.TabWidth > 0 {
+		tabStop = tr.TabWidth * tr.SpaceAdvance(scale)
+	}
+
+	pen := float32(0)
+	prev := rune(0)
+	for _, char := range line {
+		pen -= tr.Font.Kern(prev, char) * scale
+		prev = char
 
-		if glyph.RealWidth > 0 {
-			spacing := float32(glyph.RealWidth)
-			if spacing < 5 {
-				spacing = 5
+		offsets = append(offsets, pen)
+		if char == '\t' {
+			if tabStop > 0 {
+				pen = (float32(math.Floor(float64(pen/tabStop))) + 1) * tabStop
 			}
-			totalWidth += spacing * scale
-		} else {
-			totalWidth += float32(glyph.Width) * scale
+			continue
 		}
+		pen += tr.charAdvance(char, scale)
+	}
 
-		totalWidth += (1.0 + tr.CharSpacing) * scale
+	return offsets, pen
+}
+
+// VisualOffsets returns the position of each rune in line as the viewer
+// sees it: the distance from the line's left end to the glyph, along with
+// the total width of the line. For left-to-right text these are the
+// GlyphOffsets; with DirectionRightToLeft the first rune is at the right
+// end and each later one further left. Renderers place glyphs from these
+// offsets, leaving the mirroring of the screen transform to the backend.
+func (tr *TextRegion) VisualOffsets(line string, scale float32) ([]float32, float32) {
+	offsets, width := tr.GlyphOffsets(line, scale)
+	if tr.Direction != DirectionRightToLeft {
+		return offsets, width
 	}
 
-	return totalWidth
+	// Each glyph's span [start, end) is reflected about the line's middle
+	visual := make([]float32, len(offsets))
+	for i := range offsets {
+		end := width
+		if i+1 < len(offsets) {
+			end = offsets[i+1]
+		}
+		visual[i] = width - end
+	}
+	return visual, width
+}
+
+// SpaceAdvance returns how far the pen moves for a space: the font's space
+// glyph plus CharSpacing and WordSpacing. Wrapping, measurement and
+// rendering all use this one value, so wrapped lines fit as drawn.
+func (tr *TextRegion) SpaceAdvance(scale float32) float32 {
+	if tr.Font == nil {
+		return 0
+	}
+
+	advance := float32(8)
+	if glyph, exists := tr.Font.Glyph(' '); exists {
+		advance = tr.Font.GlyphSpacing(glyph) + 1.0 + tr.CharSpacing
+	}
+	return (advance + tr.WordSpacing) * scale
+}
+
+// charAdvance returns how far the pen moves after drawing a character,
+// including character spacing. Non-printable characters advance nothing.
+func (tr *TextRegion) charAdvance(char rune, scale float32) float32 {
+	if char < 32 || char > 126 {
+		return 0
+	}
+	if char == ' ' {
+		return tr.SpaceAdvance(scale)
+	}
+
+	glyph, exists := tr.Font.Glyph(char)
+	if !exists {
+		return 8 * scale
+	}
+
+	return (tr.Font.GlyphSpacing(glyph) + 1.0 + tr.CharSpacing) * scale
 }
 
 // WrapText wraps the text to fit within the region width.
 func (tr *TextRegion) WrapText() []string {
+	lines, _ := tr.wrapText()
+	return lines
+}
+
+// wrapText wraps the text to fit within the region width and also returns
+// the rune index in Text at which each wrapped line starts.
+func (tr *TextRegion) wrapText() ([]string, []int) {
 	effectiveScale := tr.Scale * tr.Parent.Scale
+	_, _, innerWidth, _ := tr.InnerRect()
 
 	rawLines := strings.Split(tr.Text, "\n")
 	var wrappedLines []string
+	var lineStarts []int
 
+	lineOffset := 0
 	for _, line := range rawLines {
 		if line == "" {
 			wrappedLines = append(wrappedLines, "")
+			lineStarts = append(lineStarts, lineOffset)
+			lineOffset++
 			continue
 		}
 
 		words := strings.Split(line, " ")
 		currentLine := ""
 		currentWidth := float32(0)
+		currentStart := lineOffset
+		wordOffset := lineOffset
 
+		spaceWidth := tr.SpaceAdvance(effectiveScale)
 		for _, word := range words {
 			wordWidth := tr.CalculateLineWidth(word, effectiveScale)
-			spaceWidth := tr.CalculateLineWidth(" ", effectiveScale)
+			if currentWidth > 0 && strings.ContainsRune(word, '\t') {
+				// Tab stops depend on the position within the line, so measure
+				// the word in place
+				wordWidth = tr.CalculateLineWidth(currentLine+" "+word, effectiveScale) - currentWidth - spaceWidth
+			}
+
+			if tr.BreakLongWords && wordWidth > innerWidth {
+				if currentWidth > 0 {
+					wrappedLines = append(wrappedLines, currentLine)
+					lineStarts = append(lineStarts, currentStart)
+				}
+
+				// Split the word at character boundaries; the final piece
+				// stays on the current line so following words can join it
+				runes := []rune(word)
+				pos := 0
+				for pos < len(runes) {
+					piece := tr.TruncateLineToFit(string(runes[pos:]), innerWidth, effectiveScale)
+					n := utf8.RuneCountInString(piece)
+					if n == 0 {
+						// Always make progress, even if one glyph is too wide
+						n = 1
+						piece = string(runes[pos])
+					}
+
+					if pos+n < len(runes) {
+						wrappedLines = append(wrappedLines, piece)
+						lineStarts = append(lineStarts, wordOffset+pos)
+					} else {
+						currentLine = piece
+						currentWidth = tr.CalculateLineWidth(piece, effectiveScale)
+						currentStart = wordOffset + pos
+					}
+					pos += n
+				}
+
+				wordOffset += len(runes) + 1
+				continue
+			}
 
-			if currentWidth > 0 && currentWidth+wordWidth+spaceWidth > tr.Width {
+			if currentWidth > 0 && currentWidth+wordWidth+spaceWidth > innerWidth {
 				wrappedLines = append(wrappedLines, currentLine)
+				lineStarts = append(lineStarts, currentStart)
 				currentLine = word
 				currentWidth = wordWidth
+				currentStart = wordOffset
 			} else {
 				if currentWidth > 0 {
 					currentLine += " " + word
@@ -246,16 +845,22 @@ func (tr *TextRegion) WrapText() []string {
 				} else {
 					currentLine = word
 					currentWidth = wordWidth
+					currentStart = wordOffset
 				}
 			}
+
+			wordOffset += utf8.RuneCountInString(word) + 1
 		}
 
 		if currentLine != "" {
 			wrappedLines = append(wrappedLines, currentLine)
+			lineStarts = append(lineStarts, currentStart)
 		}
+
+		lineOffset += utf8.RuneCountInString(line) + 1
 	}
 
-	return wrappedLines
+	return wrappedLines, lineStarts
 }
 
 // TruncateLineToFit truncates a line of text to fit within a specified width.
@@ -287,17 +892,41 @@ func (tr *TextRegion) TruncateLineToFit(line string, maxWidth float32, scale flo
 
 // GetLines returns the processed lines ready for rendering.
 func (tr *TextRegion) GetLines() []string {
+	lines, _ := tr.GetLinesWithOffsets()
+	return lines
+}
+
+// GetLinesWithOffsets returns the processed lines ready for rendering along
+// with the rune index in Text at which each line starts. Offsets let
+// renderers map glyphs back to Spans.
+func (tr *TextRegion) GetLinesWithOffsets() ([]string, []int) {
 	if tr.Font == nil || tr.Text == "" {
-		return nil
+		return nil, nil
 	}
 
 	effectiveScale := tr.Scale * tr.Parent.Scale
 
+	if tr.Direction == DirectionTopToBottom {
+		return tr.verticalRows()
+	}
+
+	if tr.SingleLine {
+		line := strings.ReplaceAll(tr.Text, "\n", " ")
+		return []string{tr.ellipsize(line)}, []int{0}
+	}
+
 	var lines []string
+	var offsets []int
 	if tr.WordWrap {
-		lines = tr.WrapText()
+		lines, offsets = tr.wrapText()
 	} else {
 		lines = strings.Split(tr.Text, "\n")
+		offsets = make([]int, len(lines))
+		offset := 0
+		for i, line := range lines {
+			offsets[i] = offset
+			offset += utf8.RuneCountInString(line) + 1
+		}
 	}
 
 	if tr.MaxLines > 0 && len(lines) > tr.MaxLines {
@@ -306,59 +935,486 @@ func (tr *TextRegion) GetLines() []string {
 			maxLineWidth := tr.CalculateLineWidth(lastVisibleLine, effectiveScale)
 			markerWidth := tr.CalculateLineWidth(tr.OverflowMarker, effectiveScale)
 
-			if maxLineWidth+markerWidth > tr.Width {
-				truncatedLine := tr.TruncateLineToFit(lastVisibleLine, tr.Width-markerWidth, effectiveScale)
+			_, _, innerWidth, _ := tr.InnerRect()
+
+			if maxLineWidth+markerWidth > innerWidth {
+				truncatedLine := tr.TruncateLineToFit(lastVisibleLine, innerWidth-markerWidth, effectiveScale)
 				lines[tr.MaxLines-1] = truncatedLine + tr.OverflowMarker
 			} else {
 				lines[tr.MaxLines-1] = lastVisibleLine + tr.OverflowMarker
 			}
 		}
 		lines = lines[:tr.MaxLines]
+		offsets = offsets[:tr.MaxLines]
 	}
 
-	return lines
+	return lines, offsets
 }
 
-// CalculateTextHeight calculates the total height of the text block.
+// ellipsize cuts line to fit the region's inner width for SingleLine,
+// replacing the removed runes with OverflowMarker as Ellipsis selects.
+func (tr *TextRegion) ellipsize(line string) string {
+	effectiveScale := tr.Scale * tr.Parent.Scale
+	_, _, innerWidth, _ := tr.InnerRect()
+	if tr.CalculateLineWidth(line, effectiveScale) <= innerWidth {
+		return line
+	}
+
+	if tr.Ellipsis != EllipsisMiddle {
+		markerWidth := tr.CalculateLineWidth(tr.OverflowMarker, effectiveScale)
+		return tr.TruncateLineToFit(line, innerWidth-markerWidth, effectiveScale) + tr.OverflowMarker
+	}
+
+	// Search for the most runes that fit, kept evenly from both ends with
+	// the extra one at the start
+	runes := []rune(line)
+	middle := func(kept int) string {
+		head := (kept + 1) / 2
+		return string(runes[:head]) + tr.OverflowMarker + string(runes[len(runes)-(kept-head):])
+	}
+	left, right := 1, len(runes)-1
+	result := 0
+	for left <= right {
+		mid := (left + right) / 2
+		if tr.CalculateLineWidth(middle(mid), effectiveScale) <= innerWidth {
+			result = mid
+			left = mid + 1
+		} else {
+			right = mid - 1
+		}
+	}
+
+	return middle(result)
+}
+
+// verticalRows splits Text into one row per glyph for DirectionTopToBottom,
+// with the rune index of each. When MaxLines cuts rows off and overflow is
+// truncated, the overflow marker replaces the last visible row.
+func (tr *TextRegion) verticalRows() ([]string, []int) {
+	var rows []string
+	var offsets []int
+	index := 0
+	for _, char := range tr.Text {
+		if char != '\n' {
+			rows = append(rows, string(char))
+			offsets = append(offsets, index)
+		}
+		index++
+	}
+
+	if tr.MaxLines > 0 && len(rows) > tr.MaxLines {
+		rows = rows[:tr.MaxLines]
+		offsets = offsets[:tr.MaxLines]
+		if tr.TruncateOverflow && tr.OverflowMarker != "" {
+			rows[tr.MaxLines-1] = tr.OverflowMarker
+		}
+	}
+
+	return rows, offsets
+}
+
+// CalculateTextHeight calculates the total height of the text block, from
+// the first line's ascent to the last line's descent.
 func (tr *TextRegion) CalculateTextHeight(lines []string) float32 {
 	if tr.Font == nil || len(lines) == 0 {
 		return 0
 	}
 
+	effectiveScale := tr.Scale * tr.Parent.Scale
+	ascent, descent := tr.Font.Metrics()
+	lineStep := float32(tr.Font.Height) * effectiveScale * tr.LineSpacing
+	return (ascent+descent)*effectiveScale + float32(len(lines)-1)*lineStep
+}
+
+// fitScaleSteps is the number of halvings FitScaleToHeight searches over,
+// finding the scale to within maxScale/2^fitScaleSteps.
+const fitScaleSteps = 16
+
+// FitScaleToHeight sets Scale to the largest value up to maxScale at which
+// the region's lines, wrapped at that scale, fit within its inner height,
+// and returns it. The search narrows the scale by repeated halving, so it
+// is found to within a fraction of a percent of maxScale. If the text does
+// not fit at any scale, Scale is set to 0.
+func (tr *TextRegion) FitScaleToHeight(maxScale float32) float32 {
+	_, _, _, innerHeight := tr.InnerRect()
+	fits := func(scale float32) bool {
+		tr.Scale = scale
+		return tr.CalculateTextHeight(tr.GetLines()) <= innerHeight
+	}
+
+	if fits(maxScale) {
+		return maxScale
+	}
+
+	// lo always fits and hi never does
+	lo, hi := float32(0), maxScale
+	for i := 0; i < fitScaleSteps; i++ {
+		mid := (lo + hi) / 2
+		if fits(mid) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+
+	tr.Scale = lo
+	return lo
+}
+
+// MaxScrollOffset returns the largest ScrollOffset that still keeps text in
+// view, i.e. the amount by which the full text height exceeds the region.
+func (tr *TextRegion) MaxScrollOffset() float32 {
+	_, _, _, innerHeight := tr.InnerRect()
+	overflow := tr.CalculateTextHeight(tr.GetLines()) - innerHeight
+	if overflow < 0 {
+		return 0
+	}
+	return overflow
+}
+
+// ScrollBy adjusts the scroll offset by delta, clamped so the region can
+// never scroll past its content.
+func (tr *TextRegion) ScrollBy(delta float32) {
+	tr.ScrollOffset = clampf(tr.ScrollOffset+delta, 0, tr.MaxScrollOffset())
+}
+
+// ScrollToTop resets the scroll offset so the first line is visible.
+func (tr *TextRegion) ScrollToTop() {
+	tr.ScrollOffset = 0
+}
+
+// ScrollToBottom scrolls so the last line of text is visible.
+func (tr *TextRegion) ScrollToBottom() {
+	tr.ScrollOffset = tr.MaxScrollOffset()
+}
+
+// CalculateLineX returns the X position at which a line of the given width
+// starts, based on horizontal alignment.
+// Note: The screen transform rotates 180° around Y, so local +X appears on
+// the viewer's left. Lines are drawn from this position toward lower X.
+// Justified lines that are not stretched are placed by JustifyFallback.
+func (tr *TextRegion) CalculateLineX(lineWidth float32) float32 {
+	x, _, width, _ := tr.InnerRect()
+	align := tr.HAlign
+	if align == AlignJustified {
+		align = tr.JustifyFallback
+	}
+	switch align {
+	case AlignCenter:
+		return x + (width+lineWidth)/2
+	case AlignRight:
+		return x + lineWidth
+	default:
+		// Left lines start at the local right edge
+		return x + width
+	}
+}
+
+// IsJustified reports whether a rendered line, the index'th of count, is
+// stretched to the region's inner width. Only lines of a justified region
+// with at least two words are, and the last line only with JustifyLastLine.
+func (tr *TextRegion) IsJustified(line string, index, count int) bool {
+	if tr.HAlign != AlignJustified || (index == count-1 && !tr.JustifyLastLine) {
+		return false
+	}
+	return len(strings.Fields(line)) > 1
+}
+
+// JustifiedGap returns the space to leave between each pair of words, the
+// parts of a justified line between single spaces, to fill the inner
+// width. The gap is never narrower than a plain space, so a line too wide
+// to fit keeps normal spacing rather than overlapping its words.
+func (tr *TextRegion) JustifiedGap(words []string, scale float32) float32 {
+	space := tr.SpaceAdvance(scale)
+	if len(words) < 2 {
+		return space
+	}
+
+	var wordsWidth float32
+	for _, word := range words {
+		wordsWidth += tr.CalculateLineWidth(word, scale)
+	}
+	_, _, width, _ := tr.InnerRect()
+	return max((width-wordsWidth)/float32(len(words)-1), space)
+}
+
+// Contains reports whether a point in the screen's local coordinates lies
+// within the region, edges included.
+func (tr *TextRegion) Contains(p Vec2) bool {
+	return p.X >= tr.X && p.X <= tr.X+tr.Width && p.Y >= tr.Y && p.Y <= tr.Y+tr.Height
+}
+
+// InnerRect returns the area text is laid out in: the region rect inset by
+// Padding on every side. The size never goes below zero.
+func (tr *TextRegion) InnerRect() (x, y, width, height float32) {
+	width = tr.Width - 2*tr.Padding
+	if width < 0 {
+		width = 0
+	}
+	height = tr.Height - 2*tr.Padding
+	if height < 0 {
+		height = 0
+	}
+	return tr.X + tr.Padding, tr.Y + tr.Padding, width, height
+}
+
+// StrokeWeight returns the glyph stroke weight to draw the region's text
+// with: DefaultBoldWeight if Bold is set, otherwise 0.
+func (tr *TextRegion) StrokeWeight() float32 {
+	if tr.Bold {
+		return DefaultBoldWeight
+	}
+	return 0
+}
+
+// LineVisible reports whether a line whose glyph origin is at lineY is
+// drawn. With ClipText that is whenever a glyph, which may reach a full
+// font height from its origin, could overlap the region rect; otherwise
+// the origin must lie within the inner rect.
+func (tr *TextRegion) LineVisible(lineY float32) bool {
+	if !tr.ClipText || tr.Font == nil {
+		_, y, _, height := tr.InnerRect()
+		return lineY >= y && lineY <= y+height
+	}
+
+	reach := float32(tr.Font.Height) * tr.Scale * tr.Parent.Scale
+	return lineY+reach >= tr.Y && lineY-reach <= tr.Y+tr.Height
+}
+
+// DashGlyphStrokes returns a glyph's strokes split into dashes when Dashed
+// is set, and strokes unchanged otherwise; see DashStrokes.
+func (tr *TextRegion) DashGlyphStrokes(strokes []Stroke) []Stroke {
+	if !tr.Dashed {
+		return strokes
+	}
+
+	dashLength, dashGap := tr.DashLength, tr.DashGap
+	if dashLength <= 0 {
+		dashLength = DefaultTextDashLength
+	}
+	if dashGap <= 0 {
+		dashGap = DefaultTextDashGap
+	}
+	return DashStrokes(strokes, dashLength, dashGap)
+}
+
+// ClipGlyphStrokes returns the parts of a glyph's strokes that lie inside
+// the region rect when ClipText is set, and strokes unchanged otherwise.
+// origin is where the glyph is drawn in the screen's local space, in which
+// the glyph is unmirrored: a stroke point p lies at origin + p*scale.
+// Clipped strokes are returned in font units, like the input.
+func (tr *TextRegion) ClipGlyphStrokes(strokes []Stroke, origin Vec2, scale float32) []Stroke {
+	if !tr.ClipText || scale == 0 {
+		return strokes
+	}
+
+	toLocal := func(p Vec2) Vec2 {
+		return Vec2{X: origin.X + p.X*scale, Y: origin.Y + p.Y*scale}
+	}
+	toFont := func(p Vec2) Vec2 {
+		return Vec2{X: (p.X - origin.X) / scale, Y: (p.Y - origin.Y) / scale}
+	}
+
+	clipped := make([]Stroke, 0, len(strokes))
+	for _, stroke := range strokes {
+		a, b := toLocal(stroke.From), toLocal(stroke.To)
+		from, to, ok := ClipSegmentToRect(a, b, tr.X, tr.Y, tr.Width, tr.Height)
+		if !ok {
+			continue
+		}
+		// Only cut ends are converted back, so whole strokes stay exact
+		if from != a {
+			stroke.From = toFont(from)
+		}
+		if to != b {
+			stroke.To = toFont(to)
+		}
+		clipped = append(clipped, stroke)
+	}
+	return clipped
+}
+
+// LineDecorations returns the underline and strikethrough segments enabled
+// on the region for a rendered line whose glyph origin is at lineY. The
+// segments span the line's CalculateLineWidth from its aligned start.
+// With ClipText the segments are clipped to the region rect.
+// Returns nil if no decoration is enabled or the line is empty.
+func (tr *TextRegion) LineDecorations(line string, lineY float32) []TextDecoration {
+	if tr.Font == nil || !(tr.Underline || tr.Strikethrough) {
+		return nil
+	}
+
+	scale := tr.Scale * tr.Parent.Scale
+	width := tr.CalculateLineWidth(line, scale)
+	if width <= 0 {
+		return nil
+	}
+	startX := tr.CalculateLineX(width)
+
+	height := float32(tr.Font.Height) * scale
+	var ys []float32
+	if tr.Underline {
+		ys = append(ys, lineY+height*underlineOffset)
+	}
+	if tr.Strikethrough {
+		ys = append(ys, lineY+height*strikethroughOffset)
+	}
+
+	var decorations []TextDecoration
+	for _, y := range ys {
+		d := TextDecoration{Y: y, StartX: startX, EndX: startX - width}
+		if tr.ClipText {
+			if y < tr.Y || y > tr.Y+tr.Height {
+				continue
+			}
+			d.StartX = min(d.StartX, tr.X+tr.Width)
+			d.EndX = max(d.EndX, tr.X)
+			if d.StartX <= d.EndX {
+				continue
+			}
+		}
+		decorations = append(decorations, d)
+	}
+	return decorations
+}
+
+// UseTextBoxes reports whether the region is drawn as line boxes when it
+// is distance world units from the viewer and a line of its text appears
+// linePixels pixels tall; see LODDistance and LODPixelSize.
+func (tr *TextRegion) UseTextBoxes(distance, linePixels float32) bool {
+	return (tr.LODDistance > 0 && distance > tr.LODDistance) ||
+		(tr.LODPixelSize > 0 && linePixels < tr.LODPixelSize)
+}
+
+// UseTextBoxesFrom is UseTextBoxes for the region seen by camera on a
+// viewport screenHeight pixels tall, measured at the region's center as
+// placed in the world by the screen transform.
+func (tr *TextRegion) UseTextBoxesFrom(camera Camera, transform Matrix, screenHeight float32) bool {
+	if tr.Font == nil || (tr.LODDistance <= 0 && tr.LODPixelSize <= 0) {
+		return false
+	}
+
+	center := transform.TransformVec3(Vec3{X: tr.X + tr.Width/2, Y: tr.Y + tr.Height/2})
+	offset := center.Sub(camera.Position)
+	forward, _, _ := camera.cameraBasis()
+	lineHeight := float32(tr.Font.Height) * tr.Scale * tr.Parent.Scale
+	return tr.UseTextBoxes(offset.Length(), camera.PixelSize(lineHeight, offset.Dot(forward), screenHeight))
+}
+
+// LineBox returns the box drawn in place of a rendered line whose glyph
+// origin is at lineY, from the baseline to the top of the capitals and
+// spanning the line's CalculateLineWidth from its aligned start. With
+// ClipText the box is clipped to the region rect. ok is false if there is
+// nothing to draw.
+func (tr *TextRegion) LineBox(line string, lineY float32) (box TextBox, ok bool) {
+	if tr.Font == nil {
+		return TextBox{}, false
+	}
+
+	scale := tr.Scale * tr.Parent.Scale
+	width := tr.CalculateLineWidth(line, scale)
+	height := float32(tr.Font.Height) * scale
+	// The line runs from its start toward lower X, like its glyphs
+	box = TextBox{
+		X:      tr.CalculateLineX(width) - width,
+		Y:      lineY + height*lineBoxBottom,
+		Width:  width,
+		Height: height * (lineBoxTop - lineBoxBottom),
+	}
+
+	if tr.ClipText {
+		right := min(box.X+box.Width, tr.X+tr.Width)
+		top := min(box.Y+box.Height, tr.Y+tr.Height)
+		box.X = max(box.X, tr.X)
+		box.Y = max(box.Y, tr.Y)
+		box.Width = right - box.X
+		box.Height = top - box.Y
+	}
+	return box, box.Width > 0 && box.Height > 0
+}
+
+// IndexAtPoint returns the line and column of the character under a point in
+// region-local coordinates, where (0, 0) is the region's (X, Y) corner and
+// axes follow the screen's local space (Y up, X mirrored on screen as drawn).
+// The column may equal the line's length when the point is past the end of
+// the line. Returns ok=false if the point is outside the region or not on a
+// line of text.
+func (tr *TextRegion) IndexAtPoint(localX, localY float32) (line int, col int, ok bool) {
+	if localX < 0 || localX > tr.Width || localY < 0 || localY > tr.Height {
+		return 0, 0, false
+	}
+
+	lines := tr.GetLines()
+	if len(lines) == 0 {
+		return 0, 0, false
+	}
+
 	effectiveScale := tr.Scale * tr.Parent.Scale
 	lineHeight := float32(tr.Font.Height) * effectiveScale
-	totalHeight := lineHeight * float32(len(lines))
+	lineStep := lineHeight * tr.LineSpacing
+	if lineStep <= 0 {
+		return 0, 0, false
+	}
+
+	// Each line's band starts at its glyph ascent above the baseline
+	firstTop := tr.CalculateStartY(tr.CalculateTextHeight(lines)) + tr.ScrollOffset + tr.textAscent()
+	offset := firstTop - (tr.Y + localY)
+	if offset < 0 {
+		return 0, 0, false
+	}
+	line = int(offset / lineStep)
+	if line >= len(lines) {
+		return 0, 0, false
+	}
 
-	if len(lines) > 1 {
-		totalHeight += (float32(len(lines)-1) * lineHeight * (tr.LineSpacing - 1.0))
+	// Measure from the line start toward lower X, matching the drawn order.
+	// Right-to-left lines start at the other end.
+	offsets, width := tr.GlyphOffsets(lines[line], effectiveScale)
+	pen := tr.CalculateLineX(width) - (tr.X + localX)
+	if tr.Direction == DirectionRightToLeft {
+		pen = width - pen
+	}
+	for i := range offsets {
+		end := width
+		if i+1 < len(offsets) {
+			end = offsets[i+1]
+		}
+		if pen < end {
+			return line, i, true
+		}
 	}
 
-	return totalHeight
+	return line, len(offsets), true
 }
 
 // CalculateStartY calculates the starting Y position based on vertical alignment.
 // Note: In 3D space Y increases upward, so "top" of region is at tr.Y + tr.Height.
 // Text lines are rendered with decreasing Y (flowing downward on screen).
+// The text block, totalTextHeight tall from the first line's ascent down
+// to the last line's descent, is aligned to the top, middle or bottom of
+// the inner rect, so Padding moves the text in from the edges.
 func (tr *TextRegion) CalculateStartY(totalTextHeight float32) float32 {
-	// Offset to account for glyph ascent (characters extend above baseline)
-	// Hershey fonts have ascent roughly 70% of the total height
-	topPadding := float32(0)
-	if tr.Font != nil && tr.Parent != nil {
-		effectiveScale := tr.Scale * tr.Parent.Scale
-		topPadding = float32(tr.Font.Height) * effectiveScale * 0.8
-	}
+	_, y, _, height := tr.InnerRect()
 
+	var top float32
 	switch tr.VAlign {
-	case AlignTop:
-		// Start below top edge to account for glyph ascent
-		return tr.Y + tr.Height - topPadding
 	case AlignMiddle:
-		// Center vertically
-		return tr.Y + tr.Height - (tr.Height-totalTextHeight)/2
+		top = y + (height+totalTextHeight)/2
 	case AlignBottom:
-		// Start so last line ends at bottom of region
-		return tr.Y + totalTextHeight
+		top = y + totalTextHeight
 	default:
-		return tr.Y + tr.Height - topPadding
+		top = y + height
+	}
+	// Lines are placed by their origin, which sits below the glyph tops
+	return top - tr.textAscent()
+}
+
+// textAscent returns how far the region's glyphs reach above their line's
+// origin, from the font's Metrics.
+func (tr *TextRegion) textAscent() float32 {
+	if tr.Font == nil || tr.Parent == nil {
+		return 0
 	}
+	ascent, _ := tr.Font.Metrics()
+	return ascent * tr.Scale * tr.Parent.Scale
 }
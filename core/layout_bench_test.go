@@ -0,0 +1,46 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+// newBenchRegion returns a region holding several paragraphs of wrapped
+// text in the default Hershey font.
+func newBenchRegion() *TextRegion {
+	paragraph := strings.Repeat("The quick brown fox jumps over the lazy dog. ", 12)
+	screen := NewTextScreen(Vec3{}, 800, 600, 1.0)
+	region := screen.AddRegion(0, 0, 800, 600)
+	region.SetContent(strings.Repeat(paragraph+"\n\n", 6), LoadHersheyFontData(), ColorWhite)
+	region.Scale = 0.8
+	return region
+}
+
+// BenchmarkTextRegion_GetLines measures a frame's worth of layout: wrapping
+// the text and measuring each line for alignment, as the renderers do.
+func BenchmarkTextRegion_GetLines(b *testing.B) {
+	region := newBenchRegion()
+	scale := region.Scale * region.Parent.Scale
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, line := range region.GetLines() {
+			region.CalculateLineX(region.CalculateLineWidth(line, scale))
+		}
+	}
+}
+
+// BenchmarkTextRegion_GetLinesUncached is the same work with the line width
+// cache discarded every frame.
+func BenchmarkTextRegion_GetLinesUncached(b *testing.B) {
+	region := newBenchRegion()
+	scale := region.Scale * region.Parent.Scale
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		region.Invalidate()
+		for _, line := range region.GetLines() {
+			region.CalculateLineX(region.CalculateLineWidth(line, scale))
+		}
+	}
+}
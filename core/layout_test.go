@@ -0,0 +1,1042 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// newTestFont returns a font where every printable character is a 10-unit
+// wide glyph, so widths are easy to reason about in tests.
+func newTestFont() *HersheyFont {
+	font := NewHersheyFont()
+	for i := 32; i < 127; i++ {
+		font.Glyphs[i-31] = HersheyGlyph{
+			Width:     10,
+			RealWidth: 10,
+			Strokes:   []Stroke{{From: Vec2{X: 0, Y: 0}, To: Vec2{X: 10, Y: 10}}},
+		}
+	}
+	return font
+}
+
+// newTestRegion returns a region on a unit-scale screen using newTestFont.
+func newTestRegion(width, height float32, text string) *TextRegion {
+	screen := NewTextScreen(Vec3{}, 1000, 1000, 1.0)
+	region := screen.AddRegion(0, 0, width, height)
+	region.SetContent(text, newTestFont(), ColorWhite)
+	return region
+}
+
+func TestTextRegion_ScrollClamping(t *testing.T) {
+	// 10 lines, each 32 units tall with 1.0 spacing = 320 units of text
+	region := newTestRegion(500, 100, strings.Repeat("line\n", 9)+"line")
+	region.LineSpacing = 1.0
+
+	if got := region.MaxScrollOffset(); got != 220 {
+		t.Fatalf("MaxScrollOffset() = %f, want 220", got)
+	}
+
+	region.ScrollBy(50)
+	if region.ScrollOffset != 50 {
+		t.Errorf("ScrollOffset = %f, want 50", region.ScrollOffset)
+	}
+
+	region.ScrollBy(1000)
+	if region.ScrollOffset != 220 {
+		t.Errorf("ScrollOffset = %f after overscroll, want 220", region.ScrollOffset)
+	}
+
+	region.ScrollBy(-1000)
+	if region.ScrollOffset != 0 {
+		t.Errorf("ScrollOffset = %f after scrolling above top, want 0", region.ScrollOffset)
+	}
+
+	region.ScrollToBottom()
+	if region.ScrollOffset != 220 {
+		t.Errorf("ScrollToBottom() offset = %f, want 220", region.ScrollOffset)
+	}
+
+	region.ScrollToTop()
+	if region.ScrollOffset != 0 {
+		t.Errorf("ScrollToTop() offset = %f, want 0", region.ScrollOffset)
+	}
+}
+
+func TestTextRegion_ScrollShortText(t *testing.T) {
+	region := newTestRegion(500, 100, "short")
+
+	region.ScrollBy(25)
+	if region.ScrollOffset != 0 {
+		t.Errorf("ScrollOffset = %f for text that fits, want 0", region.ScrollOffset)
+	}
+}
+
+func TestTextRegion_IndexAtPoint(t *testing.T) {
+	// Each glyph advances 11 units (10 wide + 1 spacing); lines are 32 tall
+	// with 1.2 spacing, so each line band is 38.4 units.
+	region := newTestRegion(500, 100, "abc\ndefgh")
+
+	tests := []struct {
+		name string
+		x, y float32
+		line int
+		col  int
+		ok   bool
+	}{
+		// Left-aligned text starts at the local right edge (x=500)
+		{"first char", 495, 90, 0, 0, true},
+		{"second char", 484, 90, 0, 1, true},
+		{"third char", 473, 90, 0, 2, true},
+		{"past line end", 400, 90, 0, 3, true},
+		{"second line", 460, 55, 1, 3, true},
+		{"below text", 495, 5, 0, 0, false},
+		{"outside region", 600, 90, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line, col, ok := region.IndexAtPoint(tt.x, tt.y)
+			if ok != tt.ok {
+				t.Fatalf("IndexAtPoint(%v, %v) ok = %v, want %v", tt.x, tt.y, ok, tt.ok)
+			}
+			if ok && (line != tt.line || col != tt.col) {
+				t.Errorf("IndexAtPoint(%v, %v) = (%d, %d), want (%d, %d)", tt.x, tt.y, line, col, tt.line, tt.col)
+			}
+		})
+	}
+}
+
+func TestTextRegion_IndexAtPoint_Centered(t *testing.T) {
+	region := newTestRegion(100, 100, "ab")
+	region.HAlign = AlignCenter
+
+	// Line width is 22, so it spans local X 39..61, drawn from 61 downward
+	if _, col, ok := region.IndexAtPoint(60, 90); !ok || col != 0 {
+		t.Errorf("IndexAtPoint(60, 90) col = %d ok = %v, want 0 true", col, ok)
+	}
+	if _, col, ok := region.IndexAtPoint(45, 90); !ok || col != 1 {
+		t.Errorf("IndexAtPoint(45, 90) col = %d ok = %v, want 1 true", col, ok)
+	}
+}
+
+func TestTextRegion_SpanColors(t *testing.T) {
+	region := newTestRegion(500, 100, "> hello")
+	region.AddSpan(0, 2, ColorGreen)
+
+	lines, offsets := region.GetLinesWithOffsets()
+	if len(lines) != 1 {
+		t.Fatalf("got %d lines, want 1", len(lines))
+	}
+
+	want := []Color{ColorGreen, ColorGreen, ColorWhite, ColorWhite, ColorWhite, ColorWhite, ColorWhite}
+	for i := range []rune(lines[0]) {
+		if got := region.ColorAt(offsets[0] + i); got != want[i] {
+			t.Errorf("glyph %d color = %v, want %v", i, got, want[i])
+		}
+	}
+
+	region.ClearSpans()
+	if got := region.ColorAt(0); got != ColorWhite {
+		t.Errorf("ColorAt(0) after ClearSpans = %v, want %v", got, ColorWhite)
+	}
+}
+
+func TestTextRegion_SpansAcrossWrappedLines(t *testing.T) {
+	// 55 units fits "aaaa" (44) but not "aaaa bbbb" (99), so each word wraps
+	text := "aaaa bbbb\ncccc"
+	region := newTestRegion(55, 200, text)
+	region.AddSpan(2, 7, ColorRed) // "aa bb"
+
+	lines, offsets := region.GetLinesWithOffsets()
+	wantLines := []string{"aaaa", "bbbb", "cccc"}
+	wantOffsets := []int{0, 5, 10}
+	if len(lines) != len(wantLines) {
+		t.Fatalf("got lines %q, want %q", lines, wantLines)
+	}
+
+	textRunes := []rune(text)
+	for i := range lines {
+		if lines[i] != wantLines[i] || offsets[i] != wantOffsets[i] {
+			t.Errorf("line %d = %q at %d, want %q at %d", i, lines[i], offsets[i], wantLines[i], wantOffsets[i])
+		}
+		// Each line must map back to the same runes in Text
+		for j, r := range []rune(lines[i]) {
+			if textRunes[offsets[i]+j] != r {
+				t.Errorf("line %d rune %d = %q, Text has %q", i, j, r, textRunes[offsets[i]+j])
+			}
+		}
+	}
+
+	// Span colors "aa" at the end of line 0 and "bb" at the start of line 1
+	wantColors := [][]Color{
+		{ColorWhite, ColorWhite, ColorRed, ColorRed},
+		{ColorRed, ColorRed, ColorWhite, ColorWhite},
+		{ColorWhite, ColorWhite, ColorWhite, ColorWhite},
+	}
+	for i := range lines {
+		for j := range wantColors[i] {
+			if got := region.ColorAt(offsets[i] + j); got != wantColors[i][j] {
+				t.Errorf("line %d glyph %d color = %v, want %v", i, j, got, wantColors[i][j])
+			}
+		}
+	}
+}
+
+func TestTextRegion_BreakLongWords(t *testing.T) {
+	token := strings.Repeat("x", 60)
+	region := newTestRegion(100, 1000, "see "+token+" end")
+
+	// Off by default: the token overflows on its own line
+	lines := region.WrapText()
+	if len(lines) != 3 || lines[1] != token {
+		t.Fatalf("default wrap = %q, want the token on its own line", lines)
+	}
+
+	region.BreakLongWords = true
+	lines, offsets := region.GetLinesWithOffsets()
+	if len(lines) < 6 {
+		t.Fatalf("got %d lines, want the token split across several lines: %q", len(lines), lines)
+	}
+
+	for i, line := range lines {
+		if w := region.CalculateLineWidth(line, 1.0); w > region.Width {
+			t.Errorf("line %d %q is %f wide, exceeds %f", i, line, w, region.Width)
+		}
+		if !strings.HasPrefix(region.Text[offsets[i]:], line) {
+			t.Errorf("line %d %q does not match Text at offset %d", i, line, offsets[i])
+		}
+	}
+
+	joined := strings.ReplaceAll(strings.Join(lines, ""), " ", "")
+	if joined != "see"+token+"end" {
+		t.Errorf("lines rejoin to %q, want every character kept in order", joined)
+	}
+}
+
+func TestTextRegion_SingleLineEllipsis(t *testing.T) {
+	// Every glyph advances 11 units, so 110 units hold 10 characters
+	region := newTestRegion(110, 100, "filename.extension")
+	region.SingleLine = true
+	region.OverflowMarker = "~"
+
+	tests := []struct {
+		mode EllipsisMode
+		want string
+	}{
+		{EllipsisEnd, "filename.~"},
+		{EllipsisMiddle, "filen~sion"},
+	}
+	for _, tt := range tests {
+		region.Ellipsis = tt.mode
+		lines := region.GetLines()
+		if len(lines) != 1 || lines[0] != tt.want {
+			t.Errorf("Ellipsis %d: GetLines = %q, want [%q]", tt.mode, lines, tt.want)
+		}
+		if width := region.CalculateLineWidth(lines[0], 1); width > region.Width {
+			t.Errorf("Ellipsis %d: line is %g wide, wider than the region's %g", tt.mode, width, region.Width)
+		}
+	}
+
+	// Wrapping and newlines give way to a single line; text that fits is
+	// left whole
+	region.Text = "ok\nrun"
+	region.Ellipsis = EllipsisMiddle
+	if lines := region.GetLines(); len(lines) != 1 || lines[0] != "ok run" {
+		t.Errorf("GetLines = %q, want [\"ok run\"]", lines)
+	}
+}
+
+func TestTextRegion_TabStops(t *testing.T) {
+	region := newTestRegion(500, 100, "")
+
+	// Space advance is 11, so the default 4-column stop is at 44
+	for _, line := range []string{"a\tb", "aa\tb", "aaa\tb", "\tb"} {
+		offsets, _ := region.GlyphOffsets(line, 1.0)
+		if got := offsets[len(offsets)-1]; got != 44 {
+			t.Errorf("%q: 'b' at %f, want 44", line, got)
+		}
+	}
+
+	// Text already past the first stop moves to the next one
+	offsets, width := region.GlyphOffsets("aaaa\tb", 1.0)
+	if got := offsets[len(offsets)-1]; got != 88 {
+		t.Errorf("'b' after a full stop at %f, want 88", got)
+	}
+	if width != 99 {
+		t.Errorf("line width = %f, want 99", width)
+	}
+	if got := region.CalculateLineWidth("aaaa\tb", 1.0); got != width {
+		t.Errorf("CalculateLineWidth = %f, want %f", got, width)
+	}
+
+	region.TabWidth = 2
+	offsets, _ = region.GlyphOffsets("a\tb", 1.0)
+	if got := offsets[2]; got != 22 {
+		t.Errorf("TabWidth 2: 'b' at %f, want 22", got)
+	}
+}
+
+func TestTextRegion_WrapWithTabs(t *testing.T) {
+	// "x\ty" alone is 55 wide, but "x\ty x\ty" is only 99 because the second
+	// tab jumps from 77 to the stop at 88. Adding widths naively would give
+	// 121 and wrap too early.
+	region := newTestRegion(120, 200, "x\ty x\ty x\ty")
+
+	lines := region.WrapText()
+	if len(lines) != 2 || lines[0] != "x\ty x\ty" {
+		t.Errorf("WrapText = %q, want [\"x\\ty x\\ty\" \"x\\ty\"]", lines)
+	}
+	for i, line := range lines {
+		if w := region.CalculateLineWidth(line, 1.0); w > region.Width {
+			t.Errorf("line %d is %f wide, exceeds %f", i, w, region.Width)
+		}
+	}
+}
+
+func TestTextRegion_WordSpacing(t *testing.T) {
+	// Words are 22 wide and spaces 11 + 9, so "aa aa aa" is 106 and only
+	// two words fit in 100; without WordSpacing all three would
+	region := newTestRegion(100, 200, "aa aa aa aa")
+	region.WordSpacing = 9
+
+	if got := region.SpaceAdvance(1.0); got != 20 {
+		t.Errorf("SpaceAdvance = %f, want 20", got)
+	}
+	if offsets, _ := region.GlyphOffsets("a a", 1.0); !reflect.DeepEqual(offsets, []float32{0, 11, 31}) {
+		t.Errorf("GlyphOffsets(\"a a\") = %v, want [0 11 31]", offsets)
+	}
+
+	lines := region.WrapText()
+	if !reflect.DeepEqual(lines, []string{"aa aa", "aa aa"}) {
+		t.Fatalf("WrapText = %q, want [\"aa aa\" \"aa aa\"]", lines)
+	}
+
+	// Each line fits as drawn, and the next word would not have
+	for i, line := range lines {
+		if w := region.CalculateLineWidth(line, 1.0); w > region.Width {
+			t.Errorf("line %d is %f wide, exceeds %f", i, w, region.Width)
+		}
+		if i+1 < len(lines) {
+			next := line + " " + strings.Fields(lines[i+1])[0]
+			if w := region.CalculateLineWidth(next, 1.0); w <= region.Width {
+				t.Errorf("line %d wrapped early: %q is only %f wide", i, next, w)
+			}
+		}
+	}
+}
+
+func TestSortScreensByDepth(t *testing.T) {
+	view := Vec3{X: 0, Y: 0, Z: -100}
+
+	near := NewTextScreen(Vec3{Z: 0}, 10, 10, 1)
+	middle := NewTextScreen(Vec3{Z: 50}, 10, 10, 1)
+	far := NewTextScreen(Vec3{Z: 100}, 10, 10, 1)
+
+	t.Run("transparent back to front", func(t *testing.T) {
+		got := SortScreensByDepth([]*TextScreen{middle, near, far}, view)
+		want := []*TextScreen{far, middle, near}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("position %d: got screen at Z=%f, want Z=%f", i, got[i].Position.Z, want[i].Position.Z)
+			}
+		}
+	})
+
+	t.Run("opaque first, front to back", func(t *testing.T) {
+		near.SetTransparency(false)
+		far.SetTransparency(false)
+		defer near.SetTransparency(true)
+		defer far.SetTransparency(true)
+
+		got := SortScreensByDepth([]*TextScreen{middle, far, near}, view)
+		want := []*TextScreen{near, far, middle}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("position %d: got screen at Z=%f, want Z=%f", i, got[i].Position.Z, want[i].Position.Z)
+			}
+		}
+	})
+
+	t.Run("input untouched", func(t *testing.T) {
+		input := []*TextScreen{near, middle, far}
+		SortScreensByDepth(input, view)
+		if input[0] != near || input[1] != middle || input[2] != far {
+			t.Error("SortScreensByDepth reordered its input slice")
+		}
+	})
+}
+
+func TestTextRegion_LineDecorations(t *testing.T) {
+	region := newTestRegion(500, 100, "hello")
+	region.Scale = 2
+
+	if got := region.LineDecorations("hello", 40); got != nil {
+		t.Errorf("LineDecorations without decorations = %v, want nil", got)
+	}
+
+	region.Underline = true
+	region.Strikethrough = true
+
+	tests := []struct {
+		align TextAlign
+	}{
+		{AlignLeft},
+		{AlignCenter},
+		{AlignRight},
+	}
+
+	for _, tt := range tests {
+		region.HAlign = tt.align
+		width := region.CalculateLineWidth("hello", 2)
+		startX := region.CalculateLineX(width)
+
+		got := region.LineDecorations("hello", 40)
+		if len(got) != 2 {
+			t.Fatalf("align %d: got %d decorations, want 2", tt.align, len(got))
+		}
+
+		// At scale 2 the 32-unit font is 64 tall: the underline sits 22
+		// below the glyph origin and the strikethrough 4 below it
+		wantY := []float32{40 - 22, 40 - 4}
+		for i, d := range got {
+			if math.Abs(float64(d.Y-wantY[i])) > 1e-4 {
+				t.Errorf("align %d decoration %d: Y = %f, want %f", tt.align, i, d.Y, wantY[i])
+			}
+			if d.StartX != startX {
+				t.Errorf("align %d decoration %d: StartX = %f, want %f", tt.align, i, d.StartX, startX)
+			}
+			if span := d.StartX - d.EndX; span != width {
+				t.Errorf("align %d decoration %d: span = %f, want %f", tt.align, i, span, width)
+			}
+		}
+	}
+
+	region.Strikethrough = false
+	if got := region.LineDecorations("hello", 40); len(got) != 1 || got[0].Y != 18 {
+		t.Errorf("underline only = %v, want one decoration at Y 18", got)
+	}
+	if got := region.LineDecorations("", 40); got != nil {
+		t.Errorf("LineDecorations(\"\") = %v, want nil", got)
+	}
+}
+
+func TestTextRegion_Padding(t *testing.T) {
+	// Four words take 209 units: they fit the full 220-unit width but not
+	// the 200-unit inner width, so 12 words wrap to 4 lines instead of 3
+	text := strings.Repeat("word ", 11) + "word"
+	region := newTestRegion(220, 300, text)
+	region.SetBorder(true, ColorWhite)
+	region.SetPadding(10)
+
+	x, y, width, height := region.InnerRect()
+	if x != 10 || y != 10 || width != 200 || height != 280 {
+		t.Fatalf("InnerRect() = %f, %f, %f, %f, want 10, 10, 200, 280", x, y, width, height)
+	}
+
+	lines := region.GetLines()
+	if len(lines) != 4 {
+		t.Errorf("got %d wrapped lines, want 4: %q", len(lines), lines)
+	}
+
+	for _, align := range []TextAlign{AlignLeft, AlignCenter, AlignRight} {
+		region.HAlign = align
+		for _, line := range lines {
+			lineWidth := region.CalculateLineWidth(line, 1)
+			start := region.CalculateLineX(lineWidth)
+			// Lines run from start toward lower X
+			if start > region.X+region.Width-region.Padding || start-lineWidth < region.X+region.Padding {
+				t.Errorf("align %d: line %q spans [%f, %f], outside the padded area", align, line, start-lineWidth, start)
+			}
+		}
+	}
+
+	// The first line's ascent stops at the top padding
+	ascent := float32(region.Font.Height) * 0.8
+	top := region.CalculateStartY(region.CalculateTextHeight(lines)) + ascent
+	if want := region.Y + region.Height - region.Padding; top != want {
+		t.Errorf("text top = %f, want %f", top, want)
+	}
+
+	// Padding larger than the region leaves an empty inner rect
+	region.SetPadding(500)
+	if _, _, w, h := region.InnerRect(); w != 0 || h != 0 {
+		t.Errorf("InnerRect() size with oversized padding = %f, %f, want 0, 0", w, h)
+	}
+}
+
+func TestTextRegion_VerticalAlignment(t *testing.T) {
+	region := newTestRegion(400, 300, "one\ntwo\nthree")
+	region.Y = 50
+	region.Scale = 0.5
+	region.LineSpacing = 1.5
+	region.SetPadding(10)
+	_, innerY, _, innerHeight := region.InnerRect()
+
+	// The block runs from the first line's ascent to the last line's
+	// descent, with lines placed by their origins below the ascent
+	lineHeight := float32(region.Font.Height) * region.Scale
+	ascent := lineHeight * 0.8
+	height := region.CalculateTextHeight(region.GetLines())
+	if want := lineHeight + 2*lineHeight*region.LineSpacing; height != want {
+		t.Fatalf("CalculateTextHeight = %g, want %g", height, want)
+	}
+
+	tests := []struct {
+		align VerticalAlign
+		top   float32 // Top of the text block
+	}{
+		{AlignTop, innerY + innerHeight},
+		{AlignMiddle, innerY + (innerHeight+height)/2},
+		{AlignBottom, innerY + height},
+	}
+	for _, tt := range tests {
+		region.VAlign = tt.align
+		top := region.CalculateStartY(height) + ascent
+		if math.Abs(float64(top-tt.top)) > 1e-4 {
+			t.Errorf("VAlign %d: block top = %g, want %g", tt.align, top, tt.top)
+		}
+		// Every alignment keeps the whole block inside the inner rect
+		if bottom := top - height; bottom < innerY-1e-4 || top > innerY+innerHeight+1e-4 {
+			t.Errorf("VAlign %d: block spans [%g, %g], outside [%g, %g]", tt.align, bottom, top, innerY, innerY+innerHeight)
+		}
+	}
+}
+
+func TestTextRegion_FontMetrics(t *testing.T) {
+	region := newTestRegion(400, 300, "one\ntwo")
+	region.Scale = 0.5
+	region.LineSpacing = 1
+	region.Font.Ascent, region.Font.Descent = 12, 4
+
+	// The block is the first line's ascent, one line step and the last
+	// line's descent
+	if height, want := region.CalculateTextHeight(region.GetLines()), float32(12+32+4)*0.5; height != want {
+		t.Errorf("CalculateTextHeight = %g, want %g", height, want)
+	}
+	_, innerY, _, innerHeight := region.InnerRect()
+	if startY, want := region.CalculateStartY(0), innerY+innerHeight-12*0.5; startY != want {
+		t.Errorf("CalculateStartY = %g, want the top less the ascent, %g", startY, want)
+	}
+}
+
+func TestTextScreen_ViewRotation(t *testing.T) {
+	position := Vec3{X: 10, Y: 5, Z: 20}
+
+	// readableNormal is the direction the readable side of the screen faces
+	readableNormal := func(screen *TextScreen, viewPoint Vec3) Vec3 {
+		m := screen.GetViewTransformMatrix(viewPoint)
+		return m.TransformVec3(Vec3{Z: 1}).Sub(m.TransformVec3(Vec3{}))
+	}
+
+	tests := []struct {
+		name      string
+		mode      BillboardMode
+		viewPoint Vec3
+		want      Vec3 // Expected readable normal
+	}{
+		// The default camera sits toward -Z, which an unrotated screen faces
+		{"none", BillboardNone, Vec3{X: 500, Y: 5, Z: 20}, Vec3{Z: -1}},
+		{"yaw in front", BillboardYaw, Vec3{X: 10, Y: 5, Z: -80}, Vec3{Z: -1}},
+		{"yaw behind", BillboardYaw, Vec3{X: 10, Y: 5, Z: 120}, Vec3{Z: 1}},
+		{"yaw side", BillboardYaw, Vec3{X: 110, Y: 5, Z: 20}, Vec3{X: 1}},
+		{"yaw above", BillboardYaw, Vec3{X: 110, Y: 105, Z: 20}, Vec3{X: 1}},
+		{"spherical diagonal", BillboardSpherical, Vec3{X: 110, Y: 105, Z: 20}, Vec3{X: 1, Y: 1}.Normalize()},
+		{"spherical overhead", BillboardSpherical, Vec3{X: 10, Y: 105, Z: 20}, Vec3{Y: 1}},
+	}
+
+	for _, tt := range tests {
+		screen := NewTextScreen(position, 100, 50, 1)
+		screen.Billboard = tt.mode
+		if got := readableNormal(screen, tt.viewPoint); !vec3Near(got, tt.want) {
+			t.Errorf("%s: readable normal = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// Billboarding keeps the screen upright: its local up stays in the
+	// vertical plane through the view direction, without rolling
+	screen := NewTextScreen(position, 100, 50, 1)
+	screen.Billboard = BillboardSpherical
+	m := screen.GetViewTransformMatrix(Vec3{X: 110, Y: 105, Z: 20})
+	up := m.TransformVec3(Vec3{Y: 1}).Sub(m.TransformVec3(Vec3{}))
+	if !vec3Near(up, Vec3{X: -1, Y: 1}.Normalize()) {
+		t.Errorf("spherical up = %v, want {-0.707 0.707 0}", up)
+	}
+
+	// A view point at the screen's position leaves the rotation alone
+	screen.Rotation = Vec3{Y: 30}
+	if got := screen.ViewRotation(position); got != screen.Rotation {
+		t.Errorf("ViewRotation at Position = %v, want Rotation %v", got, screen.Rotation)
+	}
+}
+
+func TestTextScreen_HitTestRay(t *testing.T) {
+	screen := NewTextScreen(Vec3{X: 5, Y: -2, Z: 8}, 100, 50, 1)
+	screen.Rotation = Vec3{X: 20, Y: 35}
+	title := screen.AddRegion(0, 0, 100, 20)
+	button := screen.AddRegion(60, 5, 30, 10) // Over the title
+	body := screen.AddRegion(0, 25, 100, 25)
+
+	model := screen.GetTransformMatrix()
+	normal := model.TransformVec3(Vec3{Z: 1}).Sub(model.TransformVec3(Vec3{}))
+
+	tests := []struct {
+		name  string
+		local Vec2
+		want  *TextRegion
+	}{
+		{"title", Vec2{X: 10, Y: 10}, title},
+		{"button over title", Vec2{X: 70, Y: 8}, button},
+		{"body", Vec2{X: 50, Y: 40}, body},
+		{"gap between regions", Vec2{X: 50, Y: 22}, nil},
+	}
+
+	for _, tt := range tests {
+		// Aim at the point from the readable side, at an angle
+		target := model.TransformVec3(Vec3{X: tt.local.X, Y: tt.local.Y})
+		origin := target.Add(normal.Scale(60)).Add(Vec3{X: 10, Y: -5})
+		region, local, ok := screen.HitTestRay(origin, target.Sub(origin).Normalize())
+		if !ok {
+			t.Errorf("%s: ray missed the screen", tt.name)
+			continue
+		}
+		if region != tt.want {
+			t.Errorf("%s: hit region %p, want %p", tt.name, region, tt.want)
+		}
+		if !vec2Near(local, tt.local) {
+			t.Errorf("%s: local hit = %v, want %v", tt.name, local, tt.local)
+		}
+	}
+
+	// Past the screen's edge, parallel to it, or pointing away, it misses
+	target := model.TransformVec3(Vec3{X: 120, Y: 10})
+	if _, _, ok := screen.HitTestRay(target.Add(normal), normal.Scale(-1)); ok {
+		t.Error("ray beside the screen hit it")
+	}
+	if _, _, ok := screen.HitTestRay(Vec3{}, model.TransformVec3(Vec3{X: 1}).Sub(model.TransformVec3(Vec3{}))); ok {
+		t.Error("ray parallel to the screen hit it")
+	}
+	center := screen.Center()
+	if _, _, ok := screen.HitTestRay(center.Add(normal), normal); ok {
+		t.Error("ray pointing away from the screen hit it")
+	}
+}
+
+func TestTextScreen_Parent(t *testing.T) {
+	parent := NewTextScreen(Vec3{X: 10}, 100, 50, 1)
+	parent.Rotation = Vec3{Y: 90}
+	child := NewTextScreen(Vec3{X: 5}, 20, 10, 1)
+	if !child.SetParent(parent) {
+		t.Fatal("SetParent refused a valid parent")
+	}
+
+	// Turning the parent 90° about Y swings the child's offset from +X to
+	// -Z, and turns the child with it
+	want := NewTextScreen(Vec3{X: 10, Z: -5}, 20, 10, 1)
+	want.Rotation = Vec3{Y: 90}
+	if got := child.GetTransformMatrix(); !matrixNear(got, want.GetTransformMatrix()) {
+		t.Errorf("child transform = %v, want %v", got, want.GetTransformMatrix())
+	}
+	if got := child.WorldPosition(); !vec3Near(got, want.Position) {
+		t.Errorf("WorldPosition = %v, want %v", got, want.Position)
+	}
+
+	// An unrotated child of an unrotated parent only adds the offsets
+	parent.Rotation = Vec3{}
+	want = NewTextScreen(Vec3{X: 15}, 20, 10, 1)
+	if got := child.GetTransformMatrix(); !matrixNear(got, want.GetTransformMatrix()) {
+		t.Errorf("unrotated child transform = %v, want %v", got, want.GetTransformMatrix())
+	}
+
+	// A billboarded child follows the parent's position but faces the view
+	// point on its own
+	parent.Rotation = Vec3{X: 40, Y: 70}
+	child.Billboard = BillboardSpherical
+	viewPoint := Vec3{X: -30, Y: 20, Z: -60}
+	want = NewTextScreen(child.WorldPosition(), 20, 10, 1)
+	want.Billboard = BillboardSpherical
+	if got := child.GetViewTransformMatrix(viewPoint); !matrixNear(got, want.GetViewTransformMatrix(viewPoint)) {
+		t.Errorf("billboarded child transform = %v, want %v", got, want.GetViewTransformMatrix(viewPoint))
+	}
+}
+
+func TestTextScreen_ParentCycle(t *testing.T) {
+	a := NewTextScreen(Vec3{X: 1}, 10, 10, 1)
+	b := NewTextScreen(Vec3{X: 2}, 10, 10, 1)
+	c := NewTextScreen(Vec3{X: 4}, 10, 10, 1)
+
+	if a.SetParent(a) {
+		t.Error("SetParent accepted the screen itself")
+	}
+	if !b.SetParent(a) || !c.SetParent(b) {
+		t.Fatal("SetParent refused a chain")
+	}
+	if a.SetParent(c) {
+		t.Error("SetParent accepted a descendant")
+	}
+	if a.Parent != nil {
+		t.Errorf("refused SetParent changed Parent to %p", a.Parent)
+	}
+	if got := c.WorldPosition(); !vec3Near(got, Vec3{X: 7}) {
+		t.Errorf("chained WorldPosition = %v, want {7 0 0}", got)
+	}
+
+	// A cycle set directly is cut where it loops back rather than
+	// recursing forever
+	a.Parent = c
+	if got := c.WorldPosition(); !vec3Near(got, Vec3{X: 7}) {
+		t.Errorf("WorldPosition in a cycle = %v, want {7 0 0}", got)
+	}
+	a.Parent = a
+	if got := a.GetViewTransformMatrix(Vec3{}); !matrixNear(got, NewTextScreen(Vec3{X: 1}, 10, 10, 1).GetTransformMatrix()) {
+		t.Errorf("self-parented transform = %v, want its own", got)
+	}
+}
+
+func TestTextRegion_StrokeWeight(t *testing.T) {
+	region := newTestRegion(500, 100, "hello")
+	if got := region.StrokeWeight(); got != 0 {
+		t.Errorf("StrokeWeight() = %f, want 0", got)
+	}
+	region.Bold = true
+	if got := region.StrokeWeight(); got != DefaultBoldWeight {
+		t.Errorf("bold StrokeWeight() = %f, want %f", got, DefaultBoldWeight)
+	}
+}
+
+func TestTextRegion_TopToBottom(t *testing.T) {
+	region := newTestRegion(100, 200, "ab\nc")
+	region.Direction = DirectionTopToBottom
+	region.HAlign = AlignCenter
+
+	lines, offsets := region.GetLinesWithOffsets()
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(lines, want) {
+		t.Fatalf("rows = %q, want %q", lines, want)
+	}
+	// Offsets skip the newline so spans still line up with Text
+	if want := []int{0, 1, 3}; !reflect.DeepEqual(offsets, want) {
+		t.Errorf("row offsets = %v, want %v", offsets, want)
+	}
+
+	// Rows advance one 32 unit glyph height times 1.2 spacing downward,
+	// with the first glyph's ascent at the top of the region
+	top := float32(200)
+	for i := range lines {
+		y := top - float32(i)*38.4 - 19.2
+		line, col, ok := region.IndexAtPoint(50, y)
+		if !ok || line != i || col != 0 {
+			t.Errorf("IndexAtPoint(50, %g) = %d, %d, %v, want row %d", y, line, col, ok, i)
+		}
+	}
+
+	// Each glyph is centered across the region
+	if x := region.CalculateLineX(region.CalculateLineWidth("a", 1)); x != 55.5 {
+		t.Errorf("row start X = %g, want 55.5", x)
+	}
+
+	region.MaxLines = 2
+	region.TruncateOverflow = true
+	region.OverflowMarker = "~"
+	if lines := region.GetLines(); !reflect.DeepEqual(lines, []string{"a", "~"}) {
+		t.Errorf("truncated rows = %q, want [a ~]", lines)
+	}
+}
+
+func TestTextRegion_FitScaleToHeight(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		wordWrap bool
+	}{
+		{"wrapped", "the quick brown fox jumps over the lazy dog", true},
+		{"fixed lines", "one\ntwo\nthree\nfour", false},
+	}
+
+	for _, tt := range tests {
+		region := newTestRegion(200, 100, tt.text)
+		region.WordWrap = tt.wordWrap
+		_, _, _, innerHeight := region.InnerRect()
+
+		scale := region.FitScaleToHeight(3)
+		if region.Scale != scale || scale <= 0 || scale >= 3 {
+			t.Fatalf("%s: FitScaleToHeight = %g, Scale = %g, want a shrunk scale", tt.name, scale, region.Scale)
+		}
+		if height := region.CalculateTextHeight(region.GetLines()); height > innerHeight {
+			t.Errorf("%s: text is %g tall at scale %g, want at most %g", tt.name, height, scale, innerHeight)
+		}
+
+		region.Scale = scale + 3.0/1000
+		if height := region.CalculateTextHeight(region.GetLines()); height <= innerHeight {
+			t.Errorf("%s: text still fits at scale %g (%g tall), want overflow", tt.name, region.Scale, height)
+		}
+	}
+
+	// Text that already fits keeps the maximum
+	region := newTestRegion(500, 100, "short")
+	if got := region.FitScaleToHeight(1.5); got != 1.5 {
+		t.Errorf("FitScaleToHeight for fitting text = %g, want 1.5", got)
+	}
+}
+
+func TestTextRegion_LineWidthCache(t *testing.T) {
+	region := newTestRegion(500, 100, "abc")
+	if got := region.CalculateLineWidth("abc", 1); got != 33 {
+		t.Fatalf("CalculateLineWidth = %g, want 33", got)
+	}
+
+	// Changing the region's own settings is noticed
+	region.CharSpacing = 1
+	if got := region.CalculateLineWidth("abc", 1); got != 36 {
+		t.Errorf("after CharSpacing change = %g, want 36", got)
+	}
+
+	// Changes inside the font need Invalidate
+	region.Font.Kerning = map[[2]rune]float32{{'a', 'b'}: 2}
+	if got := region.CalculateLineWidth("abc", 1); got != 36 {
+		t.Errorf("cached width after font change = %g, want stale 36", got)
+	}
+	region.Invalidate()
+	if got := region.CalculateLineWidth("abc", 1); got != 34 {
+		t.Errorf("after Invalidate = %g, want 34", got)
+	}
+}
+
+func TestTextRegion_ConcurrentMeasurement(t *testing.T) {
+	// Run with -race: two goroutines measuring and wrapping the same
+	// region share its line width cache
+	region := newTestRegion(120, 1000, strings.Repeat("one two three four five ", 20))
+	want := len(region.GetLines())
+	region.Invalidate()
+
+	var wg sync.WaitGroup
+	counts := make([]int, 2)
+	for i := range counts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				counts[i] = len(region.GetLines())
+				region.CalculateLineWidth(fmt.Sprint("line ", j), 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i, count := range counts {
+		if count != want {
+			t.Errorf("goroutine %d wrapped to %d lines, want %d", i, count, want)
+		}
+	}
+}
+
+func TestTextRegion_RightToLeft(t *testing.T) {
+	region := newTestRegion(500, 100, "abc")
+
+	// Each glyph advances 11 units: 10 wide plus 1 spacing
+	if offsets, _ := region.VisualOffsets("abc", 1); !reflect.DeepEqual(offsets, []float32{0, 11, 22}) {
+		t.Errorf("left-to-right VisualOffsets = %v, want [0 11 22]", offsets)
+	}
+
+	region.Direction = DirectionRightToLeft
+	offsets, width := region.VisualOffsets("abc", 1)
+	if width != 33 {
+		t.Errorf("right-to-left width = %g, want 33", width)
+	}
+	if !reflect.DeepEqual(offsets, []float32{22, 11, 0}) {
+		t.Errorf("right-to-left VisualOffsets = %v, want [22 11 0]", offsets)
+	}
+
+	// The line starts at local X 500, the viewer's left, and runs toward
+	// lower X; right-to-left, the first character is at the far end
+	tests := []struct {
+		x   float32
+		col int
+	}{
+		{495, 2},
+		{480, 1},
+		{470, 0},
+		{460, 0}, // Beyond the line's start
+		{499.5, 2},
+	}
+	for _, tt := range tests {
+		if _, col, ok := region.IndexAtPoint(tt.x, 90); !ok || col != tt.col {
+			t.Errorf("IndexAtPoint(%g, 90) col = %d ok = %v, want %d", tt.x, col, ok, tt.col)
+		}
+	}
+}
+
+func TestTextRegion_ClipText(t *testing.T) {
+	region := newTestRegion(100, 40, "a")
+
+	// Origin above the region but within a font height of it
+	if region.LineVisible(60) {
+		t.Error("LineVisible(60) without ClipText = true, want false")
+	}
+	region.ClipText = true
+	if !region.LineVisible(60) {
+		t.Error("LineVisible(60) with ClipText = false, want true")
+	}
+	if region.LineVisible(80) {
+		t.Error("LineVisible(80) with ClipText = true, want false")
+	}
+
+	// At origin (50, 35) scale 2, the first stroke runs from local Y 15 to
+	// 55 and is cut at the region's top, Y 40; the second is above it
+	strokes := []Stroke{
+		{From: Vec2{X: 1, Y: -10}, To: Vec2{X: 1, Y: 10}},
+		{From: Vec2{X: 0, Y: 5}, To: Vec2{X: 5, Y: 8}},
+	}
+	got := region.ClipGlyphStrokes(strokes, Vec2{X: 50, Y: 35}, 2)
+	if len(got) != 1 || !vec2Near(got[0].From, Vec2{X: 1, Y: -10}) || !vec2Near(got[0].To, Vec2{X: 1, Y: 2.5}) {
+		t.Errorf("ClipGlyphStrokes = %v, want [{{1 -10} {1 2.5}}]", got)
+	}
+
+	region.ClipText = false
+	if got := region.ClipGlyphStrokes(strokes, Vec2{X: 50, Y: 35}, 2); !reflect.DeepEqual(got, strokes) {
+		t.Errorf("ClipGlyphStrokes without ClipText = %v, want strokes unchanged", got)
+	}
+}
+
+func TestTextRegion_Justify(t *testing.T) {
+	region := newTestRegion(100, 200, "")
+	region.HAlign = AlignJustified
+
+	tests := []struct {
+		name     string
+		line     string
+		index    int
+		lastLine bool
+		want     bool
+	}{
+		{"middle line", "aa bb", 0, false, true},
+		{"last line", "aa bb", 1, false, false},
+		{"last line stretched", "aa bb", 1, true, true},
+		{"single word", "aaaa", 0, false, false},
+		{"single word with trailing space", "aaaa ", 0, true, false},
+	}
+	for _, tt := range tests {
+		region.JustifyLastLine = tt.lastLine
+		if got := region.IsJustified(tt.line, tt.index, 2); got != tt.want {
+			t.Errorf("%s: IsJustified = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// Lines that are not stretched follow the fallback alignment
+	if got := region.CalculateLineX(22); got != 100 {
+		t.Errorf("left fallback CalculateLineX = %f, want 100", got)
+	}
+	region.JustifyFallback = AlignCenter
+	if got := region.CalculateLineX(22); got != 61 {
+		t.Errorf("center fallback CalculateLineX = %f, want 61", got)
+	}
+
+	// Two 22 wide words leave 56 between them; words too wide for the
+	// region keep a plain space rather than overlapping
+	if got := region.JustifiedGap([]string{"aa", "bb"}, 1); got != 56 {
+		t.Errorf("JustifiedGap = %f, want 56", got)
+	}
+	if got := region.JustifiedGap([]string{"aaaaa", "bbbbb"}, 1); got != region.SpaceAdvance(1) {
+		t.Errorf("overflowing JustifiedGap = %f, want a plain space %f", got, region.SpaceAdvance(1))
+	}
+}
+
+func TestTextRegion_UseTextBoxes(t *testing.T) {
+	region := newTestRegion(100, 40, "far away")
+
+	tests := []struct {
+		name       string
+		distance   float32
+		pixelSize  float32
+		linePixels float32
+		distanceAt float32
+		want       bool
+	}{
+		{"no thresholds", 0, 0, 1, 1e6, false},
+		{"large enough", 0, 8, 8, 1e6, false},
+		{"too small", 0, 8, 7.9, 0, true},
+		{"near enough", 500, 0, 1, 500, false},
+		{"too far", 500, 0, 100, 501, true},
+		{"either threshold", 500, 8, 100, 501, true},
+	}
+	for _, tt := range tests {
+		region.LODDistance = tt.distance
+		region.LODPixelSize = tt.pixelSize
+		if got := region.UseTextBoxes(tt.distanceAt, tt.linePixels); got != tt.want {
+			t.Errorf("%s: UseTextBoxes(%g, %g) = %v, want %v", tt.name, tt.distanceAt, tt.linePixels, got, tt.want)
+		}
+	}
+
+	// Lines are 32 units tall; with a 90° view on a 600px viewport they
+	// are 9600/depth pixels tall, crossing 8px at a depth of 1200
+	region.LODDistance = 0
+	region.LODPixelSize = 8
+	transform := region.Parent.GetTransformMatrix()
+	camera := Camera{Up: Vec3{Y: 1}, Fovy: 90, Projection: CameraPerspective}
+	for _, depth := range []float32{1000, 1500} {
+		camera.Position = Vec3{Z: -depth}
+		camera.Target = Vec3{Z: 1 - depth}
+		if got, want := region.UseTextBoxesFrom(camera, transform, 600), depth > 1200; got != want {
+			t.Errorf("depth %g: UseTextBoxesFrom = %v, want %v", depth, got, want)
+		}
+	}
+
+	// Orthographic lines are the same size at any depth
+	camera.Projection = CameraOrthographic
+	camera.Fovy = 3000 // 6.4px lines
+	if !region.UseTextBoxesFrom(camera, transform, 600) {
+		t.Error("orthographic: UseTextBoxesFrom = false, want true")
+	}
+
+	// Boxes run from the baseline to the capitals along the measured line
+	box, ok := region.LineBox("far away", 20)
+	width := region.CalculateLineWidth("far away", 1)
+	want := TextBox{X: region.CalculateLineX(width) - width, Y: 11, Width: width, Height: 21}
+	if !ok || box != want {
+		t.Errorf("LineBox = %+v (ok %v), want %+v", box, ok, want)
+	}
+}
+
+func TestTextRegion_DashGlyphStrokes(t *testing.T) {
+	region := newTestRegion(100, 40, "a")
+	strokes := []Stroke{{From: Vec2{}, To: Vec2{X: 10}}}
+
+	if got := region.DashGlyphStrokes(strokes); !reflect.DeepEqual(got, strokes) {
+		t.Errorf("solid DashGlyphStrokes = %v, want strokes unchanged", got)
+	}
+
+	// The default 3 on, 2 off pattern
+	region.Dashed = true
+	want := DashStrokes(strokes, DefaultTextDashLength, DefaultTextDashGap)
+	if got := region.DashGlyphStrokes(strokes); !reflect.DeepEqual(got, want) || len(got) != 2 {
+		t.Errorf("default DashGlyphStrokes = %v, want %v", got, want)
+	}
+
+	region.DashLength, region.DashGap = 1, 1
+	if got := region.DashGlyphStrokes(strokes); len(got) != 5 {
+		t.Errorf("1 on, 1 off DashGlyphStrokes gave %d dashes, want 5", len(got))
+	}
+}
+
+func TestTextScreen_Scanlines(t *testing.T) {
+	tests := []struct {
+		height, gap float32
+		want        []float32
+	}{
+		{10, 4, []float32{3, 7}},
+		{12, 4, []float32{2, 6, 10}},
+		{12, 0, []float32{2, 6, 10}}, // DefaultScanlineGap
+		{3, 4, []float32{1.5}},
+		{0, 4, nil},
+	}
+
+	for _, tt := range tests {
+		screen := NewTextScreen(Vec3{}, 100, tt.height, 1.0)
+		screen.ScanlineGap = tt.gap
+		if got := screen.Scanlines(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("height %g gap %g: Scanlines = %v, want %v", tt.height, tt.gap, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Package core provides camera projection for the Spectrex framework.
+package core
+
+import "math"
+
+// CameraNearPlane is the distance in front of the camera at which geometry
+// is clipped, matching raylib's default near clip distance.
+const CameraNearPlane = 0.01
+
+// CameraFarPlane is the distance from the camera beyond which geometry is
+// clipped, matching raylib's default far clip distance.
+const CameraFarPlane = 1000
+
+// cameraBasis returns the camera's unit forward, right and up vectors.
+func (c Camera) cameraBasis() (forward, right, up Vec3) {
+	forward = c.Target.Sub(c.Position).Normalize()
+	right = forward.Cross(c.Up).Normalize()
+	up = right.Cross(forward)
+	return forward, right, up
+}
+
+// Project maps a world-space point to normalized device coordinates, the
+// inverse of ScreenRay: ndc runs from -1 at the left/bottom edge to 1 at the
+// right/top edge. depth is the point's distance along the view direction.
+// ok is false when the point is level with or behind the camera; clip
+// geometry with ClipSegment or ClipPolygon first to keep it projectable.
+func (c Camera) Project(point Vec3, aspect float32) (ndc Vec2, depth float32, ok bool) {
+	forward, right, up := c.cameraBasis()
+	offset := point.Sub(c.Position)
+
+	depth = offset.Dot(forward)
+	if depth <= 0 {
+		return Vec2{}, depth, false
+	}
+
+	x := offset.Dot(right)
+	y := offset.Dot(up)
+
+	if c.Projection == CameraOrthographic {
+		halfHeight := c.Fovy / 2
+		halfWidth := halfHeight * aspect
+		return Vec2{X: x / halfWidth, Y: y / halfHeight}, depth, true
+	}
+
+	halfHeight := float32(math.Tan(float64(DegToRad(c.Fovy))/2)) * depth
+	halfWidth := halfHeight * aspect
+	return Vec2{X: x / halfWidth, Y: y / halfHeight}, depth, true
+}
+
+// PixelSize returns how many pixels tall a world length at the given depth
+// appears on a viewport screenHeight pixels tall. Lengths at or behind the
+// camera have no size.
+func (c Camera) PixelSize(size, depth, screenHeight float32) float32 {
+	viewHeight := c.Fovy
+	if c.Projection != CameraOrthographic {
+		viewHeight = 2 * depth * float32(math.Tan(float64(DegToRad(c.Fovy))/2))
+	}
+	if viewHeight <= 0 {
+		return 0
+	}
+	return size * screenHeight / viewHeight
+}
+
+// nearDistance returns how far point lies in front of the near plane;
+// negative values are behind it.
+func (c Camera) nearDistance(point Vec3) float32 {
+	forward, _, _ := c.cameraBasis()
+	return point.Sub(c.Position).Dot(forward) - CameraNearPlane
+}
+
+// ClipSegment returns the part of the segment from a to b that lies in
+// front of the camera's near plane, so both ends can be projected.
+// ok is false when the whole segment is behind the plane.
+func (c Camera) ClipSegment(a, b Vec3) (Vec3, Vec3, bool) {
+	da, db := c.nearDistance(a), c.nearDistance(b)
+	switch {
+	case da < 0 && db < 0:
+		return a, b, false
+	case da < 0:
+		a = a.Add(b.Sub(a).Scale(da / (da - db)))
+	case db < 0:
+		b = b.Add(a.Sub(b).Scale(db / (db - da)))
+	}
+	return a, b, true
+}
+
+// ClipPolygon returns the part of a polygon that lies in front of the
+// camera's near plane, so every vertex can be projected. Returns nil when
+// the whole polygon is behind the plane.
+func (c Camera) ClipPolygon(points []Vec3) []Vec3 {
+	var clipped []Vec3
+	for i, current := range points {
+		next := points[(i+1)%len(points)]
+		dc, dn := c.nearDistance(current), c.nearDistance(next)
+
+		if dc >= 0 {
+			clipped = append(clipped, current)
+		}
+		if (dc < 0) != (dn < 0) {
+			clipped = append(clipped, current.Add(next.Sub(current).Scale(dc/(dc-dn))))
+		}
+	}
+	if len(clipped) < 3 {
+		return nil
+	}
+	return clipped
+}
@@ -0,0 +1,106 @@
+package core
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCamera_Project_InvertsScreenRay(t *testing.T) {
+	cameras := []Camera{
+		NewDefaultCamera(),
+		{
+			Position:   Vec3{X: 0, Y: 50, Z: -50},
+			Target:     Vec3{X: 0, Y: 0, Z: 0},
+			Up:         Vec3{X: 0, Y: 1, Z: 0},
+			Fovy:       120,
+			Projection: 1,
+		},
+	}
+	points := []Vec2{{X: 0, Y: 0}, {X: 0.5, Y: -0.25}, {X: -1, Y: 1}}
+
+	for _, c := range cameras {
+		for _, ndc := range points {
+			ray := c.ScreenRay(ndc.X, ndc.Y, 1.5)
+			point := ray.Origin.Add(ray.Direction.Scale(200))
+
+			got, depth, ok := c.Project(point, 1.5)
+			if !ok {
+				t.Fatalf("projection %d: Project(%v) not ok", c.Projection, point)
+			}
+			if depth <= 0 {
+				t.Errorf("projection %d: depth = %f, want > 0", c.Projection, depth)
+			}
+			if math.Abs(float64(got.X-ndc.X)) > 1e-3 || math.Abs(float64(got.Y-ndc.Y)) > 1e-3 {
+				t.Errorf("projection %d: Project = %v, want %v", c.Projection, got, ndc)
+			}
+		}
+	}
+}
+
+func TestCamera_Project_BehindCamera(t *testing.T) {
+	c := Camera{
+		Position: Vec3{X: 0, Y: 0, Z: 10},
+		Target:   Vec3{X: 0, Y: 0, Z: 0},
+		Up:       Vec3{X: 0, Y: 1, Z: 0},
+		Fovy:     90,
+	}
+
+	if _, _, ok := c.Project(Vec3{X: 0, Y: 0, Z: 20}, 1); ok {
+		t.Error("point behind the camera should not project")
+	}
+	if _, _, ok := c.Project(c.Position, 1); ok {
+		t.Error("the camera position should not project")
+	}
+}
+
+func TestCamera_ClipSegment(t *testing.T) {
+	c := Camera{
+		Position: Vec3{X: 0, Y: 0, Z: 10},
+		Target:   Vec3{X: 0, Y: 0, Z: 0},
+		Up:       Vec3{X: 0, Y: 1, Z: 0},
+		Fovy:     90,
+	}
+	nearZ := float32(10 - CameraNearPlane)
+
+	// Fully in front: unchanged
+	a, b, ok := c.ClipSegment(Vec3{Z: 0}, Vec3{X: 1, Z: -5})
+	if !ok || a != (Vec3{Z: 0}) || b != (Vec3{X: 1, Z: -5}) {
+		t.Errorf("ClipSegment(in front) = %v, %v, %v", a, b, ok)
+	}
+
+	// Crossing the near plane: the behind end moves onto it
+	a, b, ok = c.ClipSegment(Vec3{Z: 20}, Vec3{Z: 0})
+	if !ok || math.Abs(float64(a.Z-nearZ)) > 1e-4 || b != (Vec3{Z: 0}) {
+		t.Errorf("ClipSegment(crossing) = %v, %v, %v, want start at Z %f", a, b, ok, nearZ)
+	}
+
+	// Fully behind
+	if _, _, ok := c.ClipSegment(Vec3{Z: 20}, Vec3{Z: 30}); ok {
+		t.Error("ClipSegment(behind) should not be ok")
+	}
+}
+
+func TestCamera_ClipPolygon(t *testing.T) {
+	c := Camera{
+		Position: Vec3{X: 0, Y: 0, Z: 10},
+		Target:   Vec3{X: 0, Y: 0, Z: 0},
+		Up:       Vec3{X: 0, Y: 1, Z: 0},
+		Fovy:     90,
+	}
+
+	// A triangle with one vertex behind the camera becomes a quad
+	triangle := []Vec3{{X: -1, Z: 0}, {X: 1, Z: 0}, {X: 0, Z: 20}}
+	clipped := c.ClipPolygon(triangle)
+	if len(clipped) != 4 {
+		t.Fatalf("ClipPolygon returned %d points, want 4: %v", len(clipped), clipped)
+	}
+	for _, p := range clipped {
+		if _, _, ok := c.Project(p, 1); !ok {
+			t.Errorf("clipped point %v does not project", p)
+		}
+	}
+
+	if got := c.ClipPolygon([]Vec3{{Z: 20}, {X: 1, Z: 20}, {Z: 30}}); got != nil {
+		t.Errorf("ClipPolygon(behind) = %v, want nil", got)
+	}
+}
@@ -0,0 +1,73 @@
+// Package core provides ray casting from the camera for the Spectrex framework.
+package core
+
+import "math"
+
+// Ray is a half-line starting at Origin and extending along Direction.
+type Ray struct {
+	Origin    Vec3
+	Direction Vec3 // Unit length
+}
+
+// ScreenRay returns the ray from the camera through a point on the screen
+// given in normalized device coordinates: ndcX and ndcY run from -1 at the
+// left/bottom edge to 1 at the right/top edge. aspect is the viewport
+// width divided by its height.
+//
+// Perspective rays start at the camera position and fan out by Fovy
+// (degrees). Orthographic rays are parallel to the view direction and start
+// on the camera plane, with Fovy as the height of the view.
+func (c Camera) ScreenRay(ndcX, ndcY, aspect float32) Ray {
+	forward, right, up := c.cameraBasis()
+
+	if c.Projection == CameraOrthographic {
+		halfHeight := c.Fovy / 2
+		halfWidth := halfHeight * aspect
+		origin := c.Position.
+			Add(right.Scale(ndcX * halfWidth)).
+			Add(up.Scale(ndcY * halfHeight))
+		return Ray{Origin: origin, Direction: forward}
+	}
+
+	halfHeight := float32(math.Tan(float64(DegToRad(c.Fovy)) / 2))
+	halfWidth := halfHeight * aspect
+	direction := forward.
+		Add(right.Scale(ndcX * halfWidth)).
+		Add(up.Scale(ndcY * halfHeight)).
+		Normalize()
+	return Ray{Origin: c.Position, Direction: direction}
+}
+
+// IntersectPlane returns the point where the ray crosses the plane through
+// point with the given normal. ok is false when the ray runs parallel to
+// the plane or the plane lies behind the ray's origin.
+func (r Ray) IntersectPlane(point, normal Vec3) (hit Vec3, ok bool) {
+	denom := r.Direction.Dot(normal)
+	if float32(math.Abs(float64(denom))) < 1e-6 {
+		return Vec3{}, false
+	}
+
+	t := point.Sub(r.Origin).Dot(normal) / denom
+	if t < 0 {
+		return Vec3{}, false
+	}
+	return r.Origin.Add(r.Direction.Scale(t)), true
+}
+
+// IntersectPlaneY returns the point where the ray crosses the horizontal
+// plane at height y. ok is false when the ray runs parallel to the plane or
+// the plane lies behind the ray's origin.
+func (r Ray) IntersectPlaneY(y float32) (hit Vec3, ok bool) {
+	if float32(math.Abs(float64(r.Direction.Y))) < 1e-6 {
+		return Vec3{}, false
+	}
+
+	t := (y - r.Origin.Y) / r.Direction.Y
+	if t < 0 {
+		return Vec3{}, false
+	}
+
+	hit = r.Origin.Add(r.Direction.Scale(t))
+	hit.Y = y
+	return hit, true
+}
@@ -0,0 +1,137 @@
+package core
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCamera_ScreenRay_Center(t *testing.T) {
+	c := Camera{
+		Position: Vec3{X: 0, Y: 100, Z: 0},
+		Target:   Vec3{X: 0, Y: 0, Z: 0},
+		Up:       Vec3{X: 0, Y: 0, Z: 1},
+		Fovy:     45,
+	}
+
+	ray := c.ScreenRay(0, 0, 16.0/9.0)
+	if ray.Origin != c.Position {
+		t.Errorf("Origin = %v, want camera position %v", ray.Origin, c.Position)
+	}
+	if !vec3Near(ray.Direction, Vec3{Y: -1}) {
+		t.Errorf("Direction = %v, want {0 -1 0}", ray.Direction)
+	}
+}
+
+func TestCamera_ScreenRay_Edges(t *testing.T) {
+	// Looking down -Z: screen right is +X and screen up is +Y
+	c := Camera{
+		Position: Vec3{X: 0, Y: 0, Z: 10},
+		Target:   Vec3{X: 0, Y: 0, Z: 0},
+		Up:       Vec3{X: 0, Y: 1, Z: 0},
+		Fovy:     90,
+	}
+
+	// With a 90° field of view the top edge is 45° above the view direction
+	top := c.ScreenRay(0, 1, 1)
+	s := float32(math.Sqrt2 / 2)
+	if !vec3Near(top.Direction, Vec3{Y: s, Z: -s}) {
+		t.Errorf("top Direction = %v, want {0 %f %f}", top.Direction, s, -s)
+	}
+
+	// Aspect 2 doubles the horizontal extent
+	right := c.ScreenRay(1, 0, 2)
+	want := Vec3{X: 2, Z: -1}.Normalize()
+	if !vec3Near(right.Direction, want) {
+		t.Errorf("right Direction = %v, want %v", right.Direction, want)
+	}
+}
+
+func TestCamera_ScreenRay_Orthographic(t *testing.T) {
+	c := Camera{
+		Position:   Vec3{X: 0, Y: 0, Z: 10},
+		Target:     Vec3{X: 0, Y: 0, Z: 0},
+		Up:         Vec3{X: 0, Y: 1, Z: 0},
+		Fovy:       100,
+		Projection: 1,
+	}
+
+	ray := c.ScreenRay(1, -1, 1.5)
+	if !vec3Near(ray.Origin, Vec3{X: 75, Y: -50, Z: 10}) {
+		t.Errorf("Origin = %v, want {75 -50 10}", ray.Origin)
+	}
+	if !vec3Near(ray.Direction, Vec3{Z: -1}) {
+		t.Errorf("Direction = %v, want {0 0 -1}", ray.Direction)
+	}
+}
+
+func TestRay_IntersectPlaneY(t *testing.T) {
+	tests := []struct {
+		name   string
+		ray    Ray
+		wantOk bool
+		want   Vec3
+	}{
+		{
+			name:   "straight down",
+			ray:    Ray{Origin: Vec3{X: 3, Y: 10, Z: 4}, Direction: Vec3{Y: -1}},
+			wantOk: true,
+			want:   Vec3{X: 3, Y: 0, Z: 4},
+		},
+		{
+			name:   "diagonal",
+			ray:    Ray{Origin: Vec3{X: 0, Y: 100, Z: -300}, Direction: Vec3{Y: -1, Z: 3}.Normalize()},
+			wantOk: true,
+			want:   Vec3{X: 0, Y: 0, Z: 0},
+		},
+		{
+			name:   "parallel",
+			ray:    Ray{Origin: Vec3{Y: 5}, Direction: Vec3{X: 1}},
+			wantOk: false,
+		},
+		{
+			name:   "pointing away",
+			ray:    Ray{Origin: Vec3{Y: 5}, Direction: Vec3{Y: 1}},
+			wantOk: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hit, ok := tt.ray.IntersectPlaneY(0)
+			if ok != tt.wantOk {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOk)
+			}
+			if ok && !vec3Near(hit, tt.want) {
+				t.Errorf("hit = %v, want %v", hit, tt.want)
+			}
+		})
+	}
+}
+
+func TestRay_IntersectPlane(t *testing.T) {
+	// A tilted plane through (0, 0, 10), facing either way
+	point := Vec3{Z: 10}
+	normal := Vec3{Y: 1, Z: -1}.Normalize()
+
+	ray := Ray{Origin: Vec3{}, Direction: Vec3{Z: 1}}
+	for _, n := range []Vec3{normal, normal.Scale(-1)} {
+		hit, ok := ray.IntersectPlane(point, n)
+		if !ok || !vec3Near(hit, point) {
+			t.Errorf("normal %v: hit = %v, %v; want %v", n, hit, ok, point)
+		}
+	}
+
+	// Matches IntersectPlaneY for horizontal planes
+	down := Ray{Origin: Vec3{X: 0, Y: 100, Z: -300}, Direction: Vec3{Y: -1, Z: 3}.Normalize()}
+	want, _ := down.IntersectPlaneY(5)
+	if hit, ok := down.IntersectPlane(Vec3{Y: 5}, Vec3{Y: 1}); !ok || !vec3Near(hit, want) {
+		t.Errorf("horizontal plane hit = %v, %v; want %v", hit, ok, want)
+	}
+
+	if _, ok := ray.IntersectPlane(Vec3{X: 5}, Vec3{X: 1}); ok {
+		t.Error("ray parallel to the plane hit it")
+	}
+	if _, ok := (Ray{Origin: Vec3{Z: 20}, Direction: Vec3{Z: 1}}).IntersectPlane(point, normal); ok {
+		t.Error("plane behind the ray was hit")
+	}
+}
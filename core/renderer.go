@@ -3,13 +3,30 @@
 // for different backends (raylib, SDL, OpenGL, terminal, etc.).
 package core
 
+import (
+	"math"
+	"reflect"
+	"sort"
+)
+
+// Camera projection modes.
+const (
+	CameraPerspective  = 0
+	CameraOrthographic = 1
+)
+
 // Camera represents a 3D camera for scene rendering.
 type Camera struct {
-	Position   Vec3
-	Target     Vec3
-	Up         Vec3
-	Fovy       float32
-	Projection int // 0 = perspective, 1 = orthographic
+	Position Vec3
+	Target   Vec3
+	Up       Vec3
+
+	// Fovy is the vertical field of view in degrees for a perspective
+	// camera. An orthographic camera reinterprets it as the height of the
+	// view in world units.
+	Fovy float32
+
+	Projection int // CameraPerspective or CameraOrthographic
 }
 
 // NewDefaultCamera creates a camera with sensible defaults.
@@ -19,8 +36,62 @@ func NewDefaultCamera() Camera {
 		Target:     Vec3{X: 0, Y: 0, Z: 100},
 		Up:         Vec3{X: 0, Y: 1, Z: 0},
 		Fovy:       45.0,
-		Projection: 0, // Perspective
+		Projection: CameraPerspective,
+	}
+}
+
+// WithProjection returns the camera switched to the given projection mode,
+// with Fovy converted so the plane through the target keeps the same view
+// height: an orthographic height of 2·d·tan(fovy/2), where d is the distance
+// to the target. The camera is returned unchanged if it already uses mode
+// or if its position and target coincide.
+func (c Camera) WithProjection(mode int) Camera {
+	if c.Projection == mode {
+		return c
+	}
+	distance := c.Target.Sub(c.Position).Length()
+	if distance == 0 {
+		return c
+	}
+
+	if mode == CameraOrthographic {
+		c.Fovy = 2 * distance * float32(math.Tan(float64(DegToRad(c.Fovy))/2))
+	} else {
+		c.Fovy = 2 * float32(math.Atan(float64(c.Fovy/(2*distance)))) * 180 / Pi
+	}
+	c.Projection = mode
+	return c
+}
+
+// Pan returns the camera moved by delta. Position and target move together
+// so the view direction is unchanged.
+func (c Camera) Pan(delta Vec3) Camera {
+	c.Position = c.Position.Add(delta)
+	c.Target = c.Target.Add(delta)
+	return c
+}
+
+// ClampTarget returns the camera with its target clamped to the box between
+// min and max. The position is shifted by the same amount as the target so
+// the view direction is unchanged.
+func (c Camera) ClampTarget(min, max Vec3) Camera {
+	clamped := Vec3{
+		X: clampf(c.Target.X, min.X, max.X),
+		Y: clampf(c.Target.Y, min.Y, max.Y),
+		Z: clampf(c.Target.Z, min.Z, max.Z),
+	}
+	return c.Pan(clamped.Sub(c.Target))
+}
+
+// clampf clamps v to the range [lo, hi].
+func clampf(v, lo, hi float32) float32 {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
 	}
+	return v
 }
 
 // Renderer defines the interface for rendering backends.
@@ -37,6 +108,8 @@ type Renderer interface {
 	// Primitive drawing
 	DrawLine3D(start, end Vec3, color Color)
 	DrawTriangle3D(v1, v2, v3 Vec3, color Color)
+	DrawCircle3D(center Vec3, radius float32, normal Vec3, segments int, color Color)
+	DrawArc3D(center Vec3, radius, startAngle, endAngle float32, normal Vec3, segments int, color Color)
 
 	// Utility drawing
 	DrawGrid(slices int, spacing float32)
@@ -62,6 +135,9 @@ type TextScreenRenderer interface {
 	// DrawTextScreen renders a complete text screen with all its regions.
 	DrawTextScreen(screen *TextScreen)
 
+	// DrawTextScreens renders several screens in depth order for the camera.
+	DrawTextScreens(screens []*TextScreen, camera Camera)
+
 	// DrawTextRegion renders a single text region.
 	DrawTextRegion(region *TextRegion, transform Matrix, scale float32)
 
@@ -75,19 +151,51 @@ type Object interface {
 	Draw(renderer Renderer)
 }
 
+// Layered is implemented by objects that choose their draw layer. Scene.Draw
+// draws lower layers first, so a HUD on a high layer appears over world
+// geometry. Objects that don't implement it are on layer 0.
+type Layered interface {
+	Layer() int
+}
+
+// ObjectLayer returns the draw layer of obj: its Layer if it implements
+// Layered, otherwise 0.
+func ObjectLayer(obj Object) int {
+	if layered, ok := obj.(Layered); ok {
+		return layered.Layer()
+	}
+	return 0
+}
+
+// InputHandler is implemented by objects that respond to input. When a
+// scene has an Input, Scene.Update passes it to each InputHandler just
+// before that object's Update.
+type InputHandler interface {
+	HandleInput(input Input)
+}
+
 // Scene represents a collection of objects to be rendered.
 type Scene struct {
 	Camera          Camera
 	Objects         []Object
 	BackgroundColor Color
+
+	// Animations is advanced by Update before the objects, so objects see
+	// this frame's animated values. Nil skips it; animations can still be
+	// driven by a manager of their own.
+	Animations *AnimationManager
+
+	// Input, if set, is passed to objects implementing InputHandler.
+	Input Input
 }
 
-// NewScene creates a new scene with a default camera.
+// NewScene creates a new scene with a default camera and animation manager.
 func NewScene() *Scene {
 	return &Scene{
 		Camera:          NewDefaultCamera(),
 		Objects:         make([]Object, 0),
 		BackgroundColor: ColorBlack,
+		Animations:      NewAnimationManager(),
 	}
 }
 
@@ -96,16 +204,58 @@ func (s *Scene) AddObject(obj Object) {
 	s.Objects = append(s.Objects, obj)
 }
 
-// Update updates all objects in the scene.
+// RemoveObject removes the first occurrence of obj from the scene and
+// reports whether it was found. Objects are compared with ==, so they should
+// be pointers or other comparable values; an object that can't be compared,
+// such as a struct holding a slice, is never found. It is safe to call from
+// an object's Update or Draw; the current pass still visits every object it
+// started with.
+func (s *Scene) RemoveObject(obj Object) bool {
+	// Comparing equal dynamic types that aren't comparable panics
+	if !reflect.ValueOf(obj).Comparable() {
+		return false
+	}
+	for i, o := range s.Objects {
+		if o == obj {
+			// Build a new slice so a pass iterating the old one is unaffected
+			objects := make([]Object, 0, len(s.Objects)-1)
+			objects = append(objects, s.Objects[:i]...)
+			s.Objects = append(objects, s.Objects[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Update advances the scene's animations, then updates all objects in the
+// scene, handing input to InputHandlers first. Objects added or removed
+// during the pass take effect from the next one.
 func (s *Scene) Update(deltaTime float32) {
+	if s.Animations != nil {
+		s.Animations.Update(deltaTime)
+	}
 	for _, obj := range s.Objects {
+		if handler, ok := obj.(InputHandler); ok && s.Input != nil {
+			handler.HandleInput(s.Input)
+		}
 		obj.Update(deltaTime)
 	}
 }
 
-// Draw renders all objects in the scene.
+// Draw renders all objects in the scene, lowest layer first. Objects on the
+// same layer are drawn in the order they were added.
 func (s *Scene) Draw(renderer Renderer) {
-	for _, obj := range s.Objects {
+	for _, obj := range s.DrawOrder() {
 		obj.Draw(renderer)
 	}
 }
+
+// DrawOrder returns the scene's objects in the order Draw renders them.
+func (s *Scene) DrawOrder() []Object {
+	ordered := make([]Object, len(s.Objects))
+	copy(ordered, s.Objects)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ObjectLayer(ordered[i]) < ObjectLayer(ordered[j])
+	})
+	return ordered
+}
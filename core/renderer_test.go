@@ -0,0 +1,272 @@
+package core
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestCamera_Pan(t *testing.T) {
+	c := NewDefaultCamera()
+	panned := c.Pan(Vec3{X: 10, Z: -5})
+
+	if panned.Target != (Vec3{X: 10, Y: 0, Z: 95}) {
+		t.Errorf("Target = %v, want {10 0 95}", panned.Target)
+	}
+	if panned.Position != (Vec3{X: 10, Y: 100, Z: -305}) {
+		t.Errorf("Position = %v, want {10 100 -305}", panned.Position)
+	}
+}
+
+func TestCamera_ClampTarget(t *testing.T) {
+	min := Vec3{X: -50, Y: 0, Z: -50}
+	max := Vec3{X: 50, Y: 0, Z: 150}
+
+	c := NewDefaultCamera()
+	offset := c.Position.Sub(c.Target)
+
+	// Pan well past the +X and +Z edges
+	c = c.Pan(Vec3{X: 500, Z: 500}).ClampTarget(min, max)
+
+	if c.Target != (Vec3{X: 50, Y: 0, Z: 150}) {
+		t.Errorf("Target = %v, want {50 0 150}", c.Target)
+	}
+	if got := c.Position.Sub(c.Target); got != offset {
+		t.Errorf("Position-Target offset = %v, want %v", got, offset)
+	}
+
+	// A target already inside the bounds is left alone
+	inside := NewDefaultCamera().Pan(Vec3{X: 10}).ClampTarget(min, max)
+	if inside.Target != (Vec3{X: 10, Y: 0, Z: 100}) {
+		t.Errorf("Target = %v, want {10 0 100}", inside.Target)
+	}
+}
+
+// testObject records its draws and can run a hook on Update.
+type testObject struct {
+	name     string
+	layer    int
+	onUpdate func()
+	log      *[]string
+}
+
+func (o *testObject) Update(deltaTime float32) {
+	*o.log = append(*o.log, o.name)
+	if o.onUpdate != nil {
+		o.onUpdate()
+	}
+}
+
+func (o *testObject) Draw(renderer Renderer) {
+	*o.log = append(*o.log, o.name)
+}
+
+// layeredObject is a testObject that sets its draw layer.
+type layeredObject struct {
+	testObject
+}
+
+func (o *layeredObject) Layer() int { return o.layer }
+
+func TestScene_RemoveObject(t *testing.T) {
+	var log []string
+	a := &testObject{name: "a", log: &log}
+	b := &testObject{name: "b", log: &log}
+	c := &testObject{name: "c", log: &log}
+
+	s := NewScene()
+	s.AddObject(a)
+	s.AddObject(b)
+	s.AddObject(c)
+
+	if !s.RemoveObject(b) {
+		t.Fatal("RemoveObject(b) = false, want true")
+	}
+	if s.RemoveObject(b) {
+		t.Error("RemoveObject(b) again = true, want false")
+	}
+	if len(s.Objects) != 2 || s.Objects[0] != a || s.Objects[1] != c {
+		t.Errorf("Objects after removal = %v, want [a c]", s.Objects)
+	}
+}
+
+// pathObject is a value-type Object holding a slice, so it can't be
+// compared with ==.
+type pathObject struct {
+	points []Vec3
+}
+
+func (o pathObject) Update(deltaTime float32) {}
+func (o pathObject) Draw(renderer Renderer)   {}
+
+// markerObject is a comparable value-type Object.
+type markerObject struct {
+	position Vec3
+}
+
+func (o markerObject) Update(deltaTime float32) {}
+func (o markerObject) Draw(renderer Renderer)   {}
+
+func TestScene_RemoveValueObject(t *testing.T) {
+	var log []string
+	a := &testObject{name: "a", log: &log}
+	path := pathObject{points: []Vec3{{X: 1}, {X: 2}}}
+	marker := markerObject{position: Vec3{Y: 1}}
+
+	s := NewScene()
+	s.AddObject(path)
+	s.AddObject(marker)
+	s.AddObject(a)
+
+	if s.RemoveObject(path) {
+		t.Error("RemoveObject(path) = true, want false for an uncomparable object")
+	}
+	if !s.RemoveObject(markerObject{position: Vec3{Y: 1}}) {
+		t.Error("RemoveObject(marker) = false, want true")
+	}
+	if !s.RemoveObject(a) {
+		t.Error("RemoveObject(a) = false, want true")
+	}
+	if len(s.Objects) != 1 {
+		t.Errorf("len(Objects) = %d, want 1", len(s.Objects))
+	}
+}
+
+func TestScene_RemoveDuringUpdate(t *testing.T) {
+	var log []string
+	s := NewScene()
+	a := &testObject{name: "a", log: &log}
+	b := &testObject{name: "b", log: &log}
+	c := &testObject{name: "c", log: &log}
+
+	// a despawns itself and c mid-pass; the pass still visits everything
+	a.onUpdate = func() {
+		s.RemoveObject(a)
+		s.RemoveObject(c)
+	}
+	s.AddObject(a)
+	s.AddObject(b)
+	s.AddObject(c)
+
+	s.Update(0.016)
+	if got := strings.Join(log, ""); got != "abc" {
+		t.Errorf("first pass visited %q, want %q", got, "abc")
+	}
+
+	log = nil
+	s.Update(0.016)
+	if got := strings.Join(log, ""); got != "b" {
+		t.Errorf("second pass visited %q, want %q", got, "b")
+	}
+}
+
+// inputObject records the value it was animated to as of each Update, and
+// whether Escape was pressed.
+type inputObject struct {
+	value   float32
+	seen    []float32
+	escaped bool
+}
+
+func (o *inputObject) HandleInput(input Input) {
+	o.escaped = input.IsKeyPressed(KeyEscape)
+}
+
+func (o *inputObject) Update(deltaTime float32) { o.seen = append(o.seen, o.value) }
+func (o *inputObject) Draw(renderer Renderer)   {}
+
+func TestScene_UpdateAnimationsAndInput(t *testing.T) {
+	s := NewScene()
+	obj := &inputObject{}
+	s.AddObject(obj)
+	anim := s.Animations.AnimateFloat(func(v float32) { obj.value = v }, 0, 10, 1)
+
+	// Objects see each frame's animated value, and finished animations go
+	s.Update(0.5)
+	s.Update(0.5)
+	if len(obj.seen) != 2 || obj.seen[0] != 5 || obj.seen[1] != 10 {
+		t.Errorf("object saw %v, want [5 10]", obj.seen)
+	}
+	if !anim.Completed || len(s.Animations.Animations) != 0 {
+		t.Errorf("animation not completed and removed: %d left", len(s.Animations.Animations))
+	}
+
+	// Input only reaches objects once the scene has one
+	input := &MockInput{}
+	input.Press(KeyEscape)
+	s.Update(0.1)
+	if obj.escaped {
+		t.Error("object handled input with no scene Input")
+	}
+	s.Input = input
+	s.Update(0.1)
+	if !obj.escaped {
+		t.Error("object didn't see Escape pressed")
+	}
+
+	// Scenes built without NewScene have no manager and still update
+	bare := &Scene{Objects: []Object{obj}}
+	bare.Update(0.1)
+	if len(obj.seen) != 5 {
+		t.Errorf("object updated %d times, want 5", len(obj.seen))
+	}
+}
+
+func TestScene_DrawOrder(t *testing.T) {
+	var log []string
+	hud := &layeredObject{testObject{name: "H", layer: 10, log: &log}}
+	world1 := &testObject{name: "1", log: &log}
+	background := &layeredObject{testObject{name: "B", layer: -1, log: &log}}
+	world2 := &layeredObject{testObject{name: "2", layer: 0, log: &log}}
+	hud2 := &layeredObject{testObject{name: "h", layer: 10, log: &log}}
+
+	s := NewScene()
+	for _, obj := range []Object{hud, world1, background, world2, hud2} {
+		s.AddObject(obj)
+	}
+
+	s.Draw(nil)
+	if got, want := strings.Join(log, ""), "B12Hh"; got != want {
+		t.Errorf("draw order = %q, want %q", got, want)
+	}
+
+	// Sorting for draw leaves the insertion order alone
+	if s.Objects[0] != Object(hud) {
+		t.Errorf("Objects[0] = %v, want the first added object", s.Objects[0])
+	}
+}
+
+func TestCamera_WithProjection(t *testing.T) {
+	// Target 100 units away with a 90 degree FOV: the view is 200 tall there
+	c := Camera{
+		Position: Vec3{X: 0, Y: 0, Z: -100},
+		Target:   Vec3{X: 0, Y: 0, Z: 0},
+		Up:       Vec3{X: 0, Y: 1, Z: 0},
+		Fovy:     90,
+	}
+
+	ortho := c.WithProjection(CameraOrthographic)
+	if ortho.Projection != CameraOrthographic {
+		t.Errorf("Projection = %d, want CameraOrthographic", ortho.Projection)
+	}
+	if math.Abs(float64(ortho.Fovy-200)) > 1e-3 {
+		t.Errorf("orthographic Fovy = %f, want 200", ortho.Fovy)
+	}
+
+	// A point on the target plane projects to the same place either way
+	point := Vec3{X: 30, Y: -40}
+	want, _, _ := c.Project(point, 1.5)
+	got, _, _ := ortho.Project(point, 1.5)
+	if math.Abs(float64(got.X-want.X)) > 1e-4 || math.Abs(float64(got.Y-want.Y)) > 1e-4 {
+		t.Errorf("orthographic projection = %v, want %v", got, want)
+	}
+
+	back := ortho.WithProjection(CameraPerspective)
+	if back.Projection != CameraPerspective || math.Abs(float64(back.Fovy-90)) > 1e-3 {
+		t.Errorf("round trip = %d/%f, want perspective/90", back.Projection, back.Fovy)
+	}
+
+	if same := c.WithProjection(CameraPerspective); same != c {
+		t.Errorf("switching to the current mode changed the camera: %v", same)
+	}
+}
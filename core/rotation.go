@@ -0,0 +1,122 @@
+// Package core provides rotation helpers for the Spectrex framework: Euler
+// and look-at rotation matrices, and quaternions.
+package core
+
+import "math"
+
+// EulerMatrix returns the rotation matrix for rotation, in degrees, applied
+// about X, then Y, then Z, the same rotation TransformPoly applies.
+func EulerMatrix(rotation Vec3) Matrix {
+	m := MatrixRotateX(DegToRad(rotation.X))
+	m = m.Multiply(MatrixRotateY(DegToRad(rotation.Y)))
+	return m.Multiply(MatrixRotateZ(DegToRad(rotation.Z)))
+}
+
+// LookAtMatrix returns a transform that places an object at position with
+// its local +Z pointing toward target and its local +Y as close to up as
+// possible. If target is straight along up, another up is chosen. If
+// position and target coincide, the object is only translated.
+func LookAtMatrix(position, target, up Vec3) Matrix {
+	m := MatrixTranslate(position.X, position.Y, position.Z)
+
+	forward := target.Sub(position).Normalize()
+	if forward == (Vec3{}) {
+		return m
+	}
+
+	right := up.Cross(forward)
+	if right.Length() < 1e-6 {
+		// Looking along up; any perpendicular will do
+		right = Vec3{Z: 1}.Cross(forward)
+		if right.Length() < 1e-6 {
+			right = Vec3{Y: 1}.Cross(forward)
+		}
+	}
+	right = right.Normalize()
+	newUp := forward.Cross(right)
+
+	// Rows are where the local axes land
+	m[0], m[1], m[2] = right.X, right.Y, right.Z
+	m[4], m[5], m[6] = newUp.X, newUp.Y, newUp.Z
+	m[8], m[9], m[10] = forward.X, forward.Y, forward.Z
+	return m
+}
+
+// LookAtRotation returns the Euler rotation, in degrees, that turns +Z from
+// the origin toward the direction from `from` to `to`, for use with
+// TransformPoly. It pitches about X then yaws about Y, so it never rolls.
+// Returns the zero rotation if the points coincide.
+func LookAtRotation(from, to Vec3) Vec3 {
+	dir := to.Sub(from).Normalize()
+	if dir == (Vec3{}) {
+		return Vec3{}
+	}
+
+	// Rotating +Z by pitch then yaw gives
+	// (cos pitch·sin yaw, -sin pitch, cos pitch·cos yaw)
+	yaw := float32(math.Atan2(float64(dir.X), float64(dir.Z))) * 180 / Pi
+	pitch := -float32(math.Asin(float64(clampf(dir.Y, -1, 1)))) * 180 / Pi
+	return Vec3{X: pitch, Y: yaw}
+}
+
+// Quaternion represents a rotation as a unit quaternion.
+type Quaternion struct {
+	X, Y, Z, W float32
+}
+
+// QuaternionIdentity returns the quaternion for no rotation.
+func QuaternionIdentity() Quaternion {
+	return Quaternion{W: 1}
+}
+
+// QuaternionFromAxisAngle returns the rotation by angle radians about axis,
+// counterclockwise when looking down the axis toward the origin, matching
+// MatrixRotateX/Y/Z. A zero axis gives the identity.
+func QuaternionFromAxisAngle(axis Vec3, angle float32) Quaternion {
+	axis = axis.Normalize()
+	if axis == (Vec3{}) {
+		return QuaternionIdentity()
+	}
+	s := float32(math.Sin(float64(angle) / 2))
+	c := float32(math.Cos(float64(angle) / 2))
+	return Quaternion{X: axis.X * s, Y: axis.Y * s, Z: axis.Z * s, W: c}
+}
+
+// Multiply returns the rotation q applied after other.
+func (q Quaternion) Multiply(other Quaternion) Quaternion {
+	return Quaternion{
+		X: q.W*other.X + q.X*other.W + q.Y*other.Z - q.Z*other.Y,
+		Y: q.W*other.Y - q.X*other.Z + q.Y*other.W + q.Z*other.X,
+		Z: q.W*other.Z + q.X*other.Y - q.Y*other.X + q.Z*other.W,
+		W: q.W*other.W - q.X*other.X - q.Y*other.Y - q.Z*other.Z,
+	}
+}
+
+// Normalize returns the quaternion scaled to unit length, correcting drift
+// from repeated multiplication. The zero quaternion gives the identity.
+func (q Quaternion) Normalize() Quaternion {
+	length := float32(math.Sqrt(float64(q.X*q.X + q.Y*q.Y + q.Z*q.Z + q.W*q.W)))
+	if length == 0 {
+		return QuaternionIdentity()
+	}
+	return Quaternion{X: q.X / length, Y: q.Y / length, Z: q.Z / length, W: q.W / length}
+}
+
+// ToMatrix returns the rotation matrix for the quaternion.
+func (q Quaternion) ToMatrix() Matrix {
+	xx, yy, zz := q.X*q.X, q.Y*q.Y, q.Z*q.Z
+	xy, xz, yz := q.X*q.Y, q.X*q.Z, q.Y*q.Z
+	wx, wy, wz := q.W*q.X, q.W*q.Y, q.W*q.Z
+
+	return Matrix{
+		1 - 2*(yy+zz), 2 * (xy + wz), 2 * (xz - wy), 0,
+		2 * (xy - wz), 1 - 2*(xx+zz), 2 * (yz + wx), 0,
+		2 * (xz + wy), 2 * (yz - wx), 1 - 2*(xx+yy), 0,
+		0, 0, 0, 1,
+	}
+}
+
+// RotateVec3 returns v rotated by the quaternion.
+func (q Quaternion) RotateVec3(v Vec3) Vec3 {
+	return q.ToMatrix().TransformVec3(v)
+}
@@ -0,0 +1,175 @@
+package core
+
+import (
+	"math"
+	"testing"
+)
+
+// matrixNear reports whether two matrices match to within float error.
+func matrixNear(a, b Matrix) bool {
+	for i := range a {
+		if math.Abs(float64(a[i]-b[i])) > 1e-4 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMatrix_Inverse(t *testing.T) {
+	scale := func(x, y, z float32) Matrix {
+		return Matrix{x, 0, 0, 0, 0, y, 0, 0, 0, 0, z, 0, 0, 0, 0, 1}
+	}
+	matrices := []Matrix{
+		MatrixIdentity(),
+		MatrixTranslate(3, -4, 5),
+		EulerMatrix(Vec3{X: -20, Y: 130, Z: 75}).Multiply(MatrixTranslate(5, -2, 8)),
+		scale(2, 0.5, 3).Multiply(MatrixRotateY(1.2)).Multiply(MatrixTranslate(-1, 7, 0)),
+	}
+
+	for _, m := range matrices {
+		inv, ok := m.Inverse()
+		if !ok {
+			t.Errorf("Inverse(%v) failed", m)
+			continue
+		}
+		if got := m.Multiply(inv); !matrixNear(got, MatrixIdentity()) {
+			t.Errorf("m × Inverse(m) = %v, want identity", got)
+		}
+		p := Vec3{X: 1, Y: 2, Z: 3}
+		if got := inv.TransformVec3(m.TransformVec3(p)); !vec3Near(got, p) {
+			t.Errorf("Inverse round trip of %v = %v", p, got)
+		}
+	}
+
+	if _, ok := scale(1, 0, 1).Inverse(); ok {
+		t.Error("Inverse of a flattening matrix succeeded")
+	}
+}
+
+func TestTransformPolyMatrix_MatchesEuler(t *testing.T) {
+	poly := MakePoly(6, 10, 0.3)
+	poly = append(poly, Vec3{X: 1, Y: 2, Z: 3})
+	position := Vec3{X: 5, Y: -2, Z: 8}
+
+	rotations := []Vec3{
+		{},
+		{X: 90},
+		{Y: 90},
+		{Z: 90},
+		{X: 30, Y: 45},
+		{X: -20, Y: 130, Z: 75},
+	}
+
+	for _, rotation := range rotations {
+		want := TransformPoly(poly, position, rotation)
+		m := EulerMatrix(rotation).Multiply(MatrixTranslate(position.X, position.Y, position.Z))
+		got := TransformPolyMatrix(poly, m)
+		for i := range want {
+			if !vec3Near(got[i], want[i]) {
+				t.Errorf("rotation %v vertex %d: matrix path = %v, Euler path = %v", rotation, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestLookAtMatrix(t *testing.T) {
+	position := Vec3{X: 1, Y: 2, Z: 3}
+	tests := []struct {
+		name   string
+		target Vec3
+		up     Vec3
+	}{
+		{"along +X", Vec3{X: 11, Y: 2, Z: 3}, Vec3{Y: 1}},
+		{"diagonal", Vec3{X: -4, Y: 7, Z: 13}, Vec3{Y: 1}},
+		{"straight up", Vec3{X: 1, Y: 20, Z: 3}, Vec3{Y: 1}},
+		{"tilted up", Vec3{X: 1, Y: 2, Z: -10}, Vec3{X: 1, Y: 1}},
+	}
+
+	for _, tt := range tests {
+		m := LookAtMatrix(position, tt.target, tt.up)
+
+		if got := m.TransformVec3(Vec3{}); !vec3Near(got, position) {
+			t.Errorf("%s: origin maps to %v, want %v", tt.name, got, position)
+		}
+		forward := m.TransformVec3(Vec3{Z: 1}).Sub(position)
+		if want := tt.target.Sub(position).Normalize(); !vec3Near(forward, want) {
+			t.Errorf("%s: +Z maps to %v, want %v", tt.name, forward, want)
+		}
+
+		// The axes stay unit length, perpendicular and right-handed
+		right := m.TransformVec3(Vec3{X: 1}).Sub(position)
+		up := m.TransformVec3(Vec3{Y: 1}).Sub(position)
+		if !vec3Near(right.Cross(up), forward) {
+			t.Errorf("%s: X × Y = %v, want +Z axis %v", tt.name, right.Cross(up), forward)
+		}
+		// Local up leans toward the requested up when it can
+		if tt.name != "straight up" && up.Dot(tt.up) <= 0 {
+			t.Errorf("%s: up %v points away from %v", tt.name, up, tt.up)
+		}
+	}
+
+	// Looking at itself only translates
+	if m := LookAtMatrix(position, position, Vec3{Y: 1}); !matrixNear(m, MatrixTranslate(1, 2, 3)) {
+		t.Errorf("LookAtMatrix at its own position = %v, want a translation", m)
+	}
+}
+
+func TestLookAtRotation(t *testing.T) {
+	from := Vec3{X: 1, Y: 1, Z: 1}
+	targets := []Vec3{
+		{X: 1, Y: 1, Z: 10},
+		{X: 10, Y: 1, Z: 1},
+		{X: -5, Y: 8, Z: -2},
+		{X: 1, Y: -9, Z: 1},
+	}
+
+	for _, to := range targets {
+		rotation := LookAtRotation(from, to)
+		if rotation.Z != 0 {
+			t.Errorf("LookAtRotation(%v) = %v, want no roll", to, rotation)
+		}
+		got := TransformPoly([]Vec3{{Z: 1}}, Vec3{}, rotation)[0]
+		if want := to.Sub(from).Normalize(); !vec3Near(got, want) {
+			t.Errorf("LookAtRotation(%v) turns +Z to %v, want %v", to, got, want)
+		}
+	}
+}
+
+func TestQuaternion_ToMatrix(t *testing.T) {
+	angle := DegToRad(35)
+	tests := []struct {
+		axis Vec3
+		want Matrix
+	}{
+		{Vec3{X: 1}, MatrixRotateX(angle)},
+		{Vec3{Y: 1}, MatrixRotateY(angle)},
+		{Vec3{Z: 1}, MatrixRotateZ(angle)},
+		{Vec3{}, MatrixIdentity()},
+	}
+
+	for _, tt := range tests {
+		if got := QuaternionFromAxisAngle(tt.axis, angle).ToMatrix(); !matrixNear(got, tt.want) {
+			t.Errorf("axis %v: ToMatrix = %v, want %v", tt.axis, got, tt.want)
+		}
+	}
+
+	// q.Multiply(r) rotates by r first, like r's matrix times q's
+	q := QuaternionFromAxisAngle(Vec3{X: 1, Y: 2, Z: 0.5}, 1.1)
+	r := QuaternionFromAxisAngle(Vec3{X: -1, Z: 1}, 0.4)
+	if got, want := q.Multiply(r).ToMatrix(), r.ToMatrix().Multiply(q.ToMatrix()); !matrixNear(got, want) {
+		t.Errorf("q.Multiply(r).ToMatrix() = %v, want %v", got, want)
+	}
+
+	// Euler rotations compose the same way as their matrices
+	euler := QuaternionFromAxisAngle(Vec3{Z: 1}, DegToRad(75)).
+		Multiply(QuaternionFromAxisAngle(Vec3{Y: 1}, DegToRad(130))).
+		Multiply(QuaternionFromAxisAngle(Vec3{X: 1}, DegToRad(-20)))
+	if got, want := euler.ToMatrix(), EulerMatrix(Vec3{X: -20, Y: 130, Z: 75}); !matrixNear(got, want) {
+		t.Errorf("composed Euler quaternion = %v, want %v", got, want)
+	}
+
+	v := Vec3{X: 3, Y: -1, Z: 2}
+	if got := q.Normalize().RotateVec3(v); math.Abs(float64(got.Length()-v.Length())) > 1e-4 {
+		t.Errorf("rotation changed length: %v -> %v", v, got)
+	}
+}
@@ -30,6 +30,35 @@ func (v Vec3) Scale(s float32) Vec3 {
 	return Vec3{X: v.X * s, Y: v.Y * s, Z: v.Z * s}
 }
 
+// Dot returns the dot product of two vectors.
+func (v Vec3) Dot(other Vec3) float32 {
+	return v.X*other.X + v.Y*other.Y + v.Z*other.Z
+}
+
+// Cross returns the cross product of two vectors.
+func (v Vec3) Cross(other Vec3) Vec3 {
+	return Vec3{
+		X: v.Y*other.Z - v.Z*other.Y,
+		Y: v.Z*other.X - v.X*other.Z,
+		Z: v.X*other.Y - v.Y*other.X,
+	}
+}
+
+// Length returns the length of the vector.
+func (v Vec3) Length() float32 {
+	return float32(math.Sqrt(float64(v.Dot(v))))
+}
+
+// Normalize returns the unit vector in the same direction.
+// The zero vector is returned unchanged.
+func (v Vec3) Normalize() Vec3 {
+	length := v.Length()
+	if length == 0 {
+		return v
+	}
+	return v.Scale(1 / length)
+}
+
 // Color represents an RGBA color.
 type Color struct {
 	R, G, B, A uint8
@@ -122,6 +151,39 @@ func (m Matrix) Multiply(other Matrix) Matrix {
 	return result
 }
 
+// Inverse returns the matrix that undoes m, for affine transforms such as
+// the rotations, scales and translations core builds. Returns false if m
+// squashes space flat and cannot be undone.
+func (m Matrix) Inverse() (Matrix, bool) {
+	// Cofactors of the upper-left 3x3, which holds rotation and scale
+	c00 := m[5]*m[10] - m[6]*m[9]
+	c01 := m[6]*m[8] - m[4]*m[10]
+	c02 := m[4]*m[9] - m[5]*m[8]
+	det := m[0]*c00 + m[1]*c01 + m[2]*c02
+	if float32(math.Abs(float64(det))) < 1e-12 {
+		return Matrix{}, false
+	}
+	inv := 1 / det
+
+	var r Matrix
+	r[0] = c00 * inv
+	r[1] = (m[2]*m[9] - m[1]*m[10]) * inv
+	r[2] = (m[1]*m[6] - m[2]*m[5]) * inv
+	r[4] = c01 * inv
+	r[5] = (m[0]*m[10] - m[2]*m[8]) * inv
+	r[6] = (m[2]*m[4] - m[0]*m[6]) * inv
+	r[8] = c02 * inv
+	r[9] = (m[1]*m[8] - m[0]*m[9]) * inv
+	r[10] = (m[0]*m[5] - m[1]*m[4]) * inv
+
+	// The translation is undone after the rotation and scale are
+	r[12] = -(m[12]*r[0] + m[13]*r[4] + m[14]*r[8])
+	r[13] = -(m[12]*r[1] + m[13]*r[5] + m[14]*r[9])
+	r[14] = -(m[12]*r[2] + m[13]*r[6] + m[14]*r[10])
+	r[15] = 1
+	return r, true
+}
+
 // TransformVec3 transforms a Vec3 by this matrix.
 func (m Matrix) TransformVec3(v Vec3) Vec3 {
 	return Vec3{
@@ -63,7 +63,13 @@ func main() {
 	})
 
 	// Add content
-	contentSection := doc.AddSection("Features", "Hershey vector fonts\nBackend-agnostic design\nText layout system\nAnimation support")
+	contentSection := doc.AddSection("Features", "")
+	contentSection.SetList([]string{
+		"Hershey vector fonts",
+		"Backend-agnostic design",
+		"Text layout system",
+		"Animation support",
+	}, false)
 	contentSection.SetStyle(core.TextStyle{
 		Font:        font,
 		Color:       core.ColorYellow,
@@ -92,14 +98,15 @@ func main() {
 		Projection: 0,
 	}
 
+	input := raylib.NewInput()
 	totalTime := float32(0)
 
 	for !rl.WindowShouldClose() {
-		if rl.IsKeyPressed(rl.KeyEscape) {
+		if input.IsKeyPressed(core.KeyEscape) {
 			break
 		}
 
-		deltaTime := rl.GetFrameTime()
+		deltaTime := input.FrameTime()
 		totalTime += deltaTime
 
 		// Animate camera
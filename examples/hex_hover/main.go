@@ -0,0 +1,76 @@
+// Hex hover demo for Spectrex highlighting the cell and edge under the mouse.
+package main
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"github.com/chazu/spectrex/backends/raylib"
+	"github.com/chazu/spectrex/core"
+)
+
+const gridRadius = 4
+
+func main() {
+	rl.InitWindow(1280, 720, "Spectrex Hex Hover")
+	defer rl.CloseWindow()
+	rl.SetTargetFPS(60)
+
+	renderer := raylib.NewRenderer(1280, 720)
+	defer renderer.Close()
+
+	grid := core.NewHexGrid[int](gridRadius)
+	hexConfig := core.DefaultHexRenderConfig(20)
+	hexConfig.DefaultCell.FillColor = core.Color{R: 20, G: 30, B: 50, A: 255}
+	hexConfig.DefaultEdge.Color = core.ColorSkyBlue
+	hexRenderer := raylib.NewHexRenderer(hexConfig)
+	gridData := core.PrepareGridRenderData(grid, hexConfig)
+
+	// The grid lies on the XZ plane, so world X/Z are the hit tester's pixels
+	hitTester := core.NewHexHitTester(hexConfig.Layout, hexConfig.HexRadius, 3)
+
+	cellHighlight := core.HexCellStyle{FillColor: core.Color{R: 255, G: 165, B: 0, A: 160}}
+	edgeHighlight := core.HexEdgeStyle{Color: core.ColorYellow, Thickness: 1.5}
+
+	camera := core.Camera{
+		Position:   core.Vec3{X: 0, Y: 260, Z: -220},
+		Target:     core.Vec3{X: 0, Y: 0, Z: 0},
+		Up:         core.Vec3{X: 0, Y: 1, Z: 0},
+		Fovy:       45.0,
+		Projection: 0,
+	}
+
+	input := raylib.NewInput()
+	for !rl.WindowShouldClose() {
+		if input.IsKeyPressed(core.KeyEscape) {
+			break
+		}
+
+		hexRenderer.ClearHighlight()
+		hexRenderer.ClearEdgeHighlight()
+
+		renderer.BeginFrame()
+		renderer.Begin3D(camera)
+
+		// Begin3D records the camera, so the mouse ray matches this frame
+		mouse := input.MousePosition()
+		if hit, ok := renderer.ScreenToWorldXZ(int32(mouse.X), int32(mouse.Y)); ok {
+			result := hitTester.HitTestInGrid(hit.X, hit.Z, gridRadius)
+			switch result.Type {
+			case core.HexHitCell:
+				hexRenderer.SetHighlight(result.Cell, cellHighlight)
+			case core.HexHitEdge:
+				hexRenderer.SetHighlight(result.Cell, cellHighlight)
+				hexRenderer.SetEdgeHighlight(result.Edge, edgeHighlight)
+			}
+		}
+
+		hexRenderer.DrawGrid(gridData)
+
+		renderer.End3D()
+		renderer.End3DAndBlit()
+
+		renderer.DrawText2D("Hex Hover - move the mouse over the grid", 10, 10, 20, core.ColorWhite)
+
+		renderer.EndFrame()
+	}
+}
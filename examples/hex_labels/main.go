@@ -0,0 +1,64 @@
+// Hex labels demo for Spectrex showing axial coordinates drawn in each cell.
+package main
+
+import (
+	"fmt"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"github.com/chazu/spectrex/backends/raylib"
+	"github.com/chazu/spectrex/core"
+)
+
+func main() {
+	rl.InitWindow(1280, 720, "Spectrex Hex Labels")
+	defer rl.CloseWindow()
+	rl.SetTargetFPS(60)
+
+	renderer := raylib.NewRenderer(1280, 720)
+	defer renderer.Close()
+
+	font := core.LoadHersheyFontData()
+
+	grid := core.NewHexGrid[int](2)
+	hexConfig := core.DefaultHexRenderConfig(30)
+	hexConfig.DefaultCell.FillColor = core.Color{R: 20, G: 30, B: 50, A: 255}
+	hexConfig.DefaultEdge.Color = core.ColorSkyBlue
+	hexRenderer := raylib.NewHexRenderer(hexConfig)
+	gridData := core.PrepareGridRenderData(grid, hexConfig)
+
+	// Pre-format labels once; the grid is static
+	labels := make(map[core.HexCoord]string, grid.Size())
+	for _, coord := range grid.All() {
+		labels[coord] = fmt.Sprintf("%d,%d", coord.Q, coord.R)
+	}
+
+	camera := core.Camera{
+		Position:   core.Vec3{X: 0, Y: 260, Z: -200},
+		Target:     core.Vec3{X: 0, Y: 0, Z: 0},
+		Up:         core.Vec3{X: 0, Y: 1, Z: 0},
+		Fovy:       45.0,
+		Projection: 0,
+	}
+
+	for !rl.WindowShouldClose() {
+		if rl.IsKeyPressed(rl.KeyEscape) {
+			break
+		}
+
+		renderer.BeginFrame()
+		renderer.Begin3D(camera)
+
+		hexRenderer.DrawGrid(gridData)
+		for coord, label := range labels {
+			hexRenderer.DrawCellLabel(coord, label, font, core.ColorYellow)
+		}
+
+		renderer.End3D()
+		renderer.End3DAndBlit()
+
+		renderer.DrawText2D("Hex Labels - axial (q,r) coordinates", 10, 10, 20, core.ColorWhite)
+
+		renderer.EndFrame()
+	}
+}
@@ -0,0 +1,52 @@
+// Hex minimap demo for Spectrex drawing a hex grid as a 2D screen overlay.
+package main
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"github.com/chazu/spectrex/backends/raylib"
+	"github.com/chazu/spectrex/core"
+)
+
+func main() {
+	rl.InitWindow(1280, 720, "Spectrex Hex Minimap")
+	defer rl.CloseWindow()
+	rl.SetTargetFPS(60)
+
+	renderer := raylib.NewRenderer(1280, 720)
+	defer renderer.Close()
+
+	grid := core.NewHexGrid[int](2)
+
+	// Place the minimap in the top-right corner of the window
+	hexRadius := float32(18)
+	hexConfig := core.DefaultHexRenderConfig(hexRadius)
+	hexConfig.Layout.Origin = core.Vec2{X: 1280 - 120, Y: 120}
+	hexConfig.DefaultCell.FillColor = core.Color{R: 20, G: 30, B: 50, A: 220}
+	hexConfig.DefaultEdge.Color = core.ColorSkyBlue
+	minimap := raylib.NewHexRenderer(hexConfig)
+	minimapData := core.PrepareGridRenderData(grid, hexConfig)
+
+	// Mark the player's cell
+	minimap.SetCellStyle(core.HexCoord{Q: 1, R: 0}, core.HexCellStyle{FillColor: core.ColorOrange})
+
+	camera := core.NewDefaultCamera()
+
+	for !rl.WindowShouldClose() {
+		if rl.IsKeyPressed(rl.KeyEscape) {
+			break
+		}
+
+		renderer.BeginFrame()
+		renderer.Begin3D(camera)
+		renderer.DrawGrid(10, 10.0)
+		renderer.End3D()
+		renderer.End3DAndBlit()
+
+		minimap.DrawGrid2D(minimapData)
+
+		renderer.DrawText2D("Hex Minimap - 2D overlay", 10, 10, 20, core.ColorWhite)
+
+		renderer.EndFrame()
+	}
+}
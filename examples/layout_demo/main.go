@@ -97,14 +97,15 @@ func main() {
 		Projection: 0,
 	}
 
+	input := raylib.NewInput()
 	totalTime := float32(0)
 
 	for !rl.WindowShouldClose() {
-		if rl.IsKeyPressed(rl.KeyEscape) {
+		if input.IsKeyPressed(core.KeyEscape) {
 			break
 		}
 
-		deltaTime := rl.GetFrameTime()
+		deltaTime := input.FrameTime()
 		totalTime += deltaTime
 
 		renderer.BeginFrame()
@@ -121,18 +122,18 @@ func main() {
 		// Hexagon 1: spinning on Y axis
 		rot1 := core.Vec3{X: 0, Y: totalTime * 60, Z: 0}
 		transformed1 := core.TransformPoly(hex1, centerPos, rot1)
-		drawPolygon(renderer, transformed1, core.ColorYellow)
+		renderer.DrawPolygonLines3D(transformed1, core.ColorYellow)
 
 		// Hexagon 2: spinning on X axis, offset position
 		pos2 := core.Vec3{X: centerPos.X, Y: centerPos.Y, Z: centerPos.Z}
 		rot2 := core.Vec3{X: totalTime * 45, Y: 0, Z: totalTime * 30}
 		transformed2 := core.TransformPoly(hex2, pos2, rot2)
-		drawPolygon(renderer, transformed2, core.ColorLime)
+		renderer.DrawPolygonLines3D(transformed2, core.ColorLime)
 
 		// Hexagon 3: slow spin, outer ring
 		rot3 := core.Vec3{X: 0, Y: -totalTime * 20, Z: totalTime * 10}
 		transformed3 := core.TransformPoly(hex3, centerPos, rot3)
-		drawPolygon(renderer, transformed3, core.ColorRed)
+		renderer.DrawPolygonLines3D(transformed3, core.ColorRed)
 
 		renderer.DrawGrid(10, 10.0)
 		renderer.End3D()
@@ -147,11 +148,3 @@ func main() {
 		renderer.EndFrame()
 	}
 }
-
-// drawPolygon draws a polygon as connected line segments.
-func drawPolygon(renderer *raylib.Renderer, vertices []core.Vec3, color core.Color) {
-	n := len(vertices)
-	for i := 0; i < n; i++ {
-		renderer.DrawLine3D(vertices[i], vertices[(i+1)%n], color)
-	}
-}
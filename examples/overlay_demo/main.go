@@ -0,0 +1,57 @@
+// Overlay demo for Spectrex showing 2D UI text drawn with Hershey fonts.
+package main
+
+import (
+	"fmt"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"github.com/chazu/spectrex/backends/raylib"
+	"github.com/chazu/spectrex/core"
+)
+
+func main() {
+	rl.InitWindow(1280, 720, "Spectrex Overlay Demo")
+	defer rl.CloseWindow()
+	rl.SetTargetFPS(60)
+
+	renderer := raylib.NewRenderer(1280, 720)
+	defer renderer.Close()
+
+	fontRenderer := raylib.NewFontRenderer()
+	font := core.LoadHersheyFontData()
+
+	camera := core.Camera{
+		Position:   core.Vec3{X: 0, Y: 100, Z: -200},
+		Target:     core.Vec3{X: 0, Y: 0, Z: 0},
+		Up:         core.Vec3{X: 0, Y: 1, Z: 0},
+		Fovy:       45.0,
+		Projection: 0,
+	}
+
+	for !rl.WindowShouldClose() {
+		if rl.IsKeyPressed(rl.KeyEscape) {
+			break
+		}
+
+		renderer.BeginFrame()
+		renderer.Begin3D(camera)
+		renderer.DrawGrid(10, 10.0)
+		renderer.End3D()
+
+		renderer.End3DAndBlit()
+
+		// 2D overlays in the same vector style as the 3D text
+		title := "Spectrex Overlay Demo"
+		titleSize := float32(32)
+		titleWidth := font.MeasureText(title, font.ScaleForSize(titleSize))
+		titleX := (float32(renderer.GetScreenWidth()) - titleWidth) / 2
+		fontRenderer.DrawText2D(font, title, core.Vec2{X: titleX, Y: 20}, titleSize, core.ColorOrange)
+
+		fps := fmt.Sprintf("FPS: %d", rl.GetFPS())
+		fontRenderer.DrawText2D(font, fps, core.Vec2{X: 10, Y: 10}, 16, core.ColorLime)
+		fontRenderer.DrawText2D(font, "Press ESC to exit", core.Vec2{X: 10, Y: 34}, 16, core.ColorWhite)
+
+		renderer.EndFrame()
+	}
+}
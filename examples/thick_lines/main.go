@@ -0,0 +1,69 @@
+// Thick lines demo for Spectrex showing bold vector strokes on text and hex edges.
+// Use UP/DOWN to change the stroke thickness; 0 falls back to 1px lines.
+package main
+
+import (
+	"fmt"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+
+	"github.com/chazu/spectrex/backends/raylib"
+	"github.com/chazu/spectrex/core"
+)
+
+func main() {
+	rl.InitWindow(1280, 720, "Spectrex Thick Lines")
+	defer rl.CloseWindow()
+	rl.SetTargetFPS(60)
+
+	renderer := raylib.NewRenderer(1280, 720)
+	defer renderer.Close()
+
+	fontRenderer := raylib.NewFontRenderer()
+	font := core.LoadHersheyFontData()
+
+	grid := core.NewHexGrid[int](3)
+	hexConfig := core.DefaultHexRenderConfig(20)
+	hexConfig.DefaultEdge.Color = core.ColorSkyBlue
+	hexConfig.DrawCells = false
+	hexRenderer := raylib.NewHexRenderer(hexConfig)
+	gridData := core.PrepareGridRenderData(grid, hexConfig)
+
+	camera := core.Camera{
+		Position:   core.Vec3{X: 0, Y: 180, Z: -260},
+		Target:     core.Vec3{X: 0, Y: 0, Z: 0},
+		Up:         core.Vec3{X: 0, Y: 1, Z: 0},
+		Fovy:       45.0,
+		Projection: 0,
+	}
+
+	thickness := float32(1.5)
+
+	for !rl.WindowShouldClose() {
+		if rl.IsKeyPressed(rl.KeyEscape) {
+			break
+		}
+		if rl.IsKeyPressed(rl.KeyUp) {
+			thickness += 0.5
+		}
+		if rl.IsKeyPressed(rl.KeyDown) && thickness > 0 {
+			thickness -= 0.5
+		}
+
+		fontRenderer.LineThickness = thickness
+		hexRenderer.Config.DefaultEdge.Thickness = thickness
+
+		renderer.BeginFrame()
+		renderer.Begin3D(camera)
+
+		hexRenderer.DrawGrid(gridData)
+		fontRenderer.DrawText(font, "BOLD VECTORS", core.Vec3{X: 0, Y: 60, Z: 0}, core.ColorOrange, 2.0)
+
+		renderer.End3D()
+		renderer.End3DAndBlit()
+
+		renderer.DrawText2D(fmt.Sprintf("Thickness: %.1f (UP/DOWN)", thickness), 10, 10, 20, core.ColorWhite)
+
+		renderer.EndFrame()
+	}
+}